@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+)
+
+func runStart(c *cli, fs *flag.FlagSet, args []string) error {
+	processType := fs.String("process-type", "", "the process type (required)")
+	processId := fs.String("id", "", "the processId (required)")
+	startStateId := fs.String("state", "", "the stateId of the starting state, empty for no starting state")
+	input := fs.String("input", "", "the input of the starting state in JSON")
+	timeoutSeconds := fs.Int("timeout", 0, "the timeout of the process execution in seconds, 0 means no timeout")
+	idReusePolicy := fs.String("id-reuse-policy", "", "the ProcessIdReusePolicy, e.g. ALLOW_IF_NO_RUNNING")
+	client, p, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if *processType == "" || *processId == "" {
+		return usageError("-process-type and -id are required")
+	}
+
+	options := &xc.BasicClientProcessOptions{
+		TimeoutSeconds: int32(*timeoutSeconds),
+	}
+	if *idReusePolicy != "" {
+		policy, err := xcapi.NewProcessIdReusePolicyFromValue(*idReusePolicy)
+		if err != nil {
+			return usageError("%v", err)
+		}
+		options.ProcessIdReusePolicy = policy
+	}
+	in, err := parseJson(*input)
+	if err != nil {
+		return usageError("invalid -input: %v", err)
+	}
+
+	prcExeId, err := client.StartProcess(context.Background(), *processType, *startStateId, *processId, in, options)
+	if err != nil {
+		return err
+	}
+	result := map[string]string{
+		"processId":          *processId,
+		"processExecutionId": prcExeId,
+	}
+	return p.print(result, []string{"PROCESS ID", "PROCESS EXECUTION ID"}, [][]string{{*processId, prcExeId}})
+}
+
+func runStop(c *cli, fs *flag.FlagSet, args []string) error {
+	processId := fs.String("id", "", "the processId (required)")
+	stopType := fs.String("stop-type", string(xcapi.TERMINATE), "the ProcessExecutionStopType, TERMINATE or FAIL")
+	client, _, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if *processId == "" {
+		return usageError("-id is required")
+	}
+	st, err := xcapi.NewProcessExecutionStopTypeFromValue(*stopType)
+	if err != nil {
+		return usageError("%v", err)
+	}
+	return client.StopProcess(context.Background(), *processId, *st)
+}
+
+func runDescribe(c *cli, fs *flag.FlagSet, args []string) error {
+	processId := fs.String("id", "", "the processId (required)")
+	client, p, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if *processId == "" {
+		return usageError("-id is required")
+	}
+	resp, err := client.DescribeCurrentProcessExecution(context.Background(), *processId)
+	if err != nil {
+		return err
+	}
+	return printDescribeResponse(p, *processId, resp)
+}
+
+func printDescribeResponse(p printer, processId string, resp *xcapi.ProcessExecutionDescribeResponse) error {
+	startTime := ""
+	if resp.StartTimestamp != nil {
+		startTime = time.Unix(int64(resp.GetStartTimestamp()), 0).UTC().Format(time.RFC3339)
+	}
+	return p.print(resp,
+		[]string{"PROCESS ID", "PROCESS EXECUTION ID", "PROCESS TYPE", "STATUS", "START TIME", "WORKER URL"},
+		[][]string{{
+			processId, resp.GetProcessExecutionId(), resp.GetProcessType(), string(resp.GetStatus()), startTime,
+			resp.GetWorkerUrl(),
+		}})
+}
+
+func runPublish(c *cli, fs *flag.FlagSet, args []string) error {
+	processId := fs.String("id", "", "the processId (required)")
+	queueName := fs.String("queue", "", "the local queue name (required)")
+	payload := fs.String("payload", "", "the payload of the message in JSON")
+	dedupSeed := fs.String("dedup-seed", "", "the seed to generate the DedupUUID of the message")
+	client, _, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if *processId == "" || *queueName == "" {
+		return usageError("-id and -queue are required")
+	}
+	pl, err := parseJson(*payload)
+	if err != nil {
+		return usageError("invalid -payload: %v", err)
+	}
+
+	msg := xcapi.LocalQueueMessage{
+		QueueName: *queueName,
+	}
+	if pl != nil {
+		msg.Payload, err = xc.GetDefaultObjectEncoder().Encode(pl)
+		if err != nil {
+			return err
+		}
+	}
+	if *dedupSeed != "" {
+		msg.DedupId = xcapi.PtrString(xc.GetDedupUUIDFromSeed(*dedupSeed))
+	}
+	return client.PublishToLocalQueue(context.Background(), *processId, []xcapi.LocalQueueMessage{msg})
+}
+
+func runWait(c *cli, fs *flag.FlagSet, args []string) error {
+	processId := fs.String("id", "", "the processId (required)")
+	timeout := fs.Duration("timeout", time.Minute, "the max duration to wait")
+	interval := fs.Duration("interval", time.Second, "the interval of polling the status")
+	client, p, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if *processId == "" {
+		return usageError("-id is required")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	for {
+		resp, err := client.DescribeCurrentProcessExecution(ctx, *processId)
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus() != xcapi.RUNNING {
+			if err := printDescribeResponse(p, *processId, resp); err != nil {
+				return err
+			}
+			if resp.GetStatus() != xcapi.COMPLETED {
+				return fmt.Errorf("process execution is closed as %v", resp.GetStatus())
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("process execution is still running after %v", *timeout)
+		case <-time.After(*interval):
+		}
+	}
+}
+
+// parseJson returns the JSON as is to encode, or nil if it's empty
+func parseJson(value string) (interface{}, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if !json.Valid([]byte(value)) {
+		return nil, fmt.Errorf("not a valid JSON: %v", value)
+	}
+	return json.RawMessage(value), nil
+}
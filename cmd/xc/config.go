@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+
+	"github.com/xcherryio/sdk-go/xc"
+)
+
+const (
+	envServerUrl = "XC_SERVER_URL"
+	envNamespace = "XC_NAMESPACE"
+	envWorkerUrl = "XC_WORKER_URL"
+	envOutput    = "XC_OUTPUT"
+	envConfig    = "XC_CONFIG"
+
+	defaultConfigFileName = ".xc.json"
+
+	outputTable = "table"
+	outputJson  = "json"
+)
+
+// config is resolved from the flags, then the env variables, then the config file, then the defaults
+type config struct {
+	ServerUrl string `json:"serverUrl"`
+	Namespace string `json:"namespace"`
+	WorkerUrl string `json:"workerUrl"`
+	Output    string `json:"output"`
+	Debug     bool   `json:"debug"`
+}
+
+type configFlags struct {
+	configFile string
+	serverUrl  string
+	namespace  string
+	workerUrl  string
+	output     string
+	debug      bool
+	// set is the names of the flags that are set explicitly, which override the config file
+	set map[string]bool
+}
+
+func addConfigFlags(fs *flag.FlagSet) *configFlags {
+	f := &configFlags{
+		set: map[string]bool{},
+	}
+	fs.StringVar(&f.configFile, "config", "",
+		"the config file in JSON, default: $"+envConfig+" or ~/"+defaultConfigFileName)
+	fs.StringVar(&f.serverUrl, "server", "", "the xCherry server url, env: "+envServerUrl)
+	fs.StringVar(&f.namespace, "namespace", "", "the namespace, env: "+envNamespace)
+	fs.StringVar(&f.workerUrl, "worker", "", "the worker url for starting processes, env: "+envWorkerUrl)
+	fs.StringVar(&f.output, "output", "", "the output format, table or json, env: "+envOutput)
+	fs.BoolVar(&f.debug, "debug", false, "print the requests and responses")
+	return f
+}
+
+func (f *configFlags) resolve(getenv func(string) string) (config, error) {
+	cfg := config{}
+
+	configFile := firstNonEmpty(f.configFile, getenv(envConfig))
+	mustExist := configFile != ""
+	if configFile == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			configFile = filepath.Join(home, defaultConfigFileName)
+		}
+	}
+	if configFile != "" {
+		data, err := os.ReadFile(configFile)
+		if err == nil {
+			if err := json.Unmarshal(data, &cfg); err != nil {
+				return cfg, err
+			}
+		} else if mustExist || !os.IsNotExist(err) {
+			return cfg, err
+		}
+	}
+
+	cfg.ServerUrl = firstNonEmpty(f.serverUrl, getenv(envServerUrl), cfg.ServerUrl, xc.DefaultServerUrl)
+	cfg.Namespace = firstNonEmpty(f.namespace, getenv(envNamespace), cfg.Namespace, xc.DefaultNamespace)
+	cfg.WorkerUrl = firstNonEmpty(f.workerUrl, getenv(envWorkerUrl), cfg.WorkerUrl, xc.DefaultWorkerUrl)
+	cfg.Output = firstNonEmpty(f.output, getenv(envOutput), cfg.Output, outputTable)
+	if f.set["debug"] {
+		// e.g. -debug=false turns off the debug of the config file
+		cfg.Debug = f.debug
+	}
+	if cfg.Output != outputTable && cfg.Output != outputJson {
+		return cfg, usageError("invalid output format %v, must be table or json", cfg.Output)
+	}
+	return cfg, nil
+}
+
+func (c config) toClientOptions() xc.ClientOptions {
+	return xc.ClientOptions{
+		Namespace:           c.Namespace,
+		ServerUrl:           c.ServerUrl,
+		WorkerUrl:           c.WorkerUrl,
+		ObjectEncoder:       xc.GetDefaultObjectEncoder(),
+		EnabledDebugLogging: c.Debug,
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,129 @@
+// Command xc operates the process executions of xCherry server with the BasicClient
+//
+// Usage:
+//
+//	xc <command> [flags]
+//
+// The server url and namespace are read from the flags, then the env variables(XC_SERVER_URL, XC_NAMESPACE),
+// then the config file(-config, XC_CONFIG or ~/.xc.json), e.g. {"serverUrl": "http://localhost:8801"}
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/xcherryio/sdk-go/xc"
+)
+
+func main() {
+	c := &cli{
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		getenv: os.Getenv,
+		newClient: func(options xc.ClientOptions) xc.BasicClient {
+			return xc.NewBasicClient(options)
+		},
+	}
+	os.Exit(c.run(os.Args[1:]))
+}
+
+type cli struct {
+	stdout    io.Writer
+	stderr    io.Writer
+	getenv    func(string) string
+	newClient func(options xc.ClientOptions) xc.BasicClient
+}
+
+type command struct {
+	name        string
+	description string
+	run         func(c *cli, fs *flag.FlagSet, args []string) error
+}
+
+var commands = []command{
+	{"start", "start a process execution", runStart},
+	{"stop", "stop a process execution", runStop},
+	{"describe", "describe the current process execution", runDescribe},
+	{"publish", "publish a message to a local queue of a process execution", runPublish},
+	{"wait", "wait for the current process execution to close", runWait},
+}
+
+const (
+	exitCodeOk    = 0
+	exitCodeError = 1
+	exitCodeUsage = 2
+)
+
+type errUsage struct {
+	msg string
+}
+
+func (e errUsage) Error() string {
+	return e.msg
+}
+
+func usageError(format string, args ...interface{}) error {
+	return errUsage{msg: fmt.Sprintf(format, args...)}
+}
+
+func (c *cli) run(args []string) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		c.printUsage()
+		return exitCodeUsage
+	}
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+		fs := flag.NewFlagSet("xc "+cmd.name, flag.ContinueOnError)
+		fs.SetOutput(c.stderr)
+		err := cmd.run(c, fs, args[1:])
+		if err == nil {
+			return exitCodeOk
+		}
+		if errors.Is(err, flag.ErrHelp) {
+			return exitCodeUsage
+		}
+		fmt.Fprintln(c.stderr, "Error:", err)
+		var usageErr errUsage
+		if errors.As(err, &usageErr) {
+			fs.Usage()
+			return exitCodeUsage
+		}
+		return exitCodeError
+	}
+	fmt.Fprintf(c.stderr, "unknown command %v\n", args[0])
+	c.printUsage()
+	return exitCodeUsage
+}
+
+func (c *cli) printUsage() {
+	fmt.Fprintln(c.stderr, "Usage: xc <command> [flags]")
+	fmt.Fprintln(c.stderr, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(c.stderr, "  %-10v%v\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintln(c.stderr, "Run 'xc <command> -h' for the flags of a command")
+}
+
+// parse parses the flags of the command, and returns the client and the printer by the resolved config
+func (c *cli) parse(fs *flag.FlagSet, args []string) (xc.BasicClient, printer, error) {
+	cfgFlags := addConfigFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, printer{}, err
+	}
+	fs.Visit(func(f *flag.Flag) {
+		cfgFlags.set[f.Name] = true
+	})
+	if fs.NArg() > 0 {
+		return nil, printer{}, usageError("unexpected arguments %v", fs.Args())
+	}
+	cfg, err := cfgFlags.resolve(c.getenv)
+	if err != nil {
+		return nil, printer{}, err
+	}
+	return c.newClient(cfg.toClientOptions()), printer{out: c.stdout, format: cfg.Output}, nil
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+)
+
+type cliTestTransport struct {
+	xc.ClientTransport
+	startRequest   *xcapi.ProcessExecutionStartRequest
+	publishRequest *xcapi.PublishToLocalQueueRequest
+	stopRequest    *xcapi.ProcessExecutionStopRequest
+}
+
+func (t *cliTestTransport) StartProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionStartRequest,
+) (*xcapi.ProcessExecutionStartResponse, error) {
+	t.startRequest = &request
+	return &xcapi.ProcessExecutionStartResponse{ProcessExecutionId: "execution-1"}, nil
+}
+
+func (t *cliTestTransport) StopProcessExecution(ctx context.Context, request xcapi.ProcessExecutionStopRequest) error {
+	t.stopRequest = &request
+	return nil
+}
+
+func (t *cliTestTransport) DescribeProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionDescribeRequest,
+) (*xcapi.ProcessExecutionDescribeResponse, error) {
+	return &xcapi.ProcessExecutionDescribeResponse{
+		ProcessExecutionId: xcapi.PtrString("execution-1"),
+		ProcessType:        xcapi.PtrString("OrderProcess"),
+		Status:             xcapi.COMPLETED.Ptr(),
+	}, nil
+}
+
+func (t *cliTestTransport) PublishToLocalQueue(ctx context.Context, request xcapi.PublishToLocalQueueRequest) error {
+	t.publishRequest = &request
+	return nil
+}
+
+func newTestCli(env map[string]string) (*cli, *cliTestTransport, *bytes.Buffer, *bytes.Buffer) {
+	transport := &cliTestTransport{}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	c := &cli{
+		stdout: stdout,
+		stderr: stderr,
+		getenv: func(key string) string {
+			return env[key]
+		},
+		newClient: func(options xc.ClientOptions) xc.BasicClient {
+			options.Transport = transport
+			return xc.NewBasicClient(options)
+		},
+	}
+	return c, transport, stdout, stderr
+}
+
+func TestStartAndPublish(t *testing.T) {
+	c, transport, stdout, _ := newTestCli(map[string]string{envNamespace: "ns-from-env"})
+
+	code := c.run([]string{"start", "-process-type", "OrderProcess", "-id", "order-1", "-state", "InitState",
+		"-input", `{"amount":100}`, "-id-reuse-policy", "DISALLOW_REUSE"})
+	assert.Equal(t, exitCodeOk, code)
+	assert.Equal(t, "ns-from-env", transport.startRequest.Namespace)
+	assert.Equal(t, "OrderProcess", transport.startRequest.ProcessType)
+	assert.Equal(t, `{"amount":100}`, transport.startRequest.StartStateInput.Data)
+	assert.Equal(t, xcapi.DISALLOW_REUSE, transport.startRequest.ProcessStartConfig.GetIdReusePolicy())
+	assert.Contains(t, stdout.String(), "execution-1")
+
+	code = c.run([]string{"publish", "-namespace", "ns-from-flag", "-id", "order-1", "-queue", "approval",
+		"-payload", `"approved"`, "-dedup-seed", "seed-1"})
+	assert.Equal(t, exitCodeOk, code)
+	assert.Equal(t, "ns-from-flag", transport.publishRequest.Namespace)
+	msg := transport.publishRequest.Messages[0]
+	assert.Equal(t, "approval", msg.QueueName)
+	assert.Equal(t, `"approved"`, msg.Payload.Data)
+	assert.Equal(t, xc.GetDedupUUIDFromSeed("seed-1"), msg.GetDedupId())
+
+	code = c.run([]string{"stop", "-id", "order-1", "-stop-type", "FAIL"})
+	assert.Equal(t, exitCodeOk, code)
+	assert.Equal(t, xcapi.FAIL, transport.stopRequest.GetStopType())
+}
+
+func TestDescribeOutputFormats(t *testing.T) {
+	c, _, stdout, _ := newTestCli(nil)
+	assert.Equal(t, exitCodeOk, c.run([]string{"describe", "-id", "order-1"}))
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	assert.Equal(t, 2, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "PROCESS ID"))
+	assert.Contains(t, lines[1], "COMPLETED")
+
+	stdout.Reset()
+	assert.Equal(t, exitCodeOk, c.run([]string{"wait", "-id", "order-1", "-output", "json"}))
+	var resp xcapi.ProcessExecutionDescribeResponse
+	assert.Nil(t, json.Unmarshal(stdout.Bytes(), &resp))
+	assert.Equal(t, "OrderProcess", resp.GetProcessType())
+}
+
+func TestUsageAndErrors(t *testing.T) {
+	c, _, _, stderr := newTestCli(nil)
+	assert.Equal(t, exitCodeUsage, c.run(nil))
+	assert.Contains(t, stderr.String(), "describe")
+	assert.Equal(t, exitCodeUsage, c.run([]string{"unknown"}))
+	assert.Equal(t, exitCodeUsage, c.run([]string{"start", "-id", "order-1"}))
+	assert.Equal(t, exitCodeUsage, c.run([]string{"publish", "-id", "order-1", "-queue", "q", "-payload", "{"}))
+	assert.Equal(t, exitCodeUsage, c.run([]string{"describe", "-id", "order-1", "-output", "yaml"}))
+	assert.NotEqual(t, exitCodeOk, c.run([]string{"stop", "-id", "order-1", "-type", "FAIL"}))
+	assert.Contains(t, stderr.String(), "flag provided but not defined: -type")
+}
+
+func TestConfigResolution(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "xc.json")
+	assert.Nil(t, os.WriteFile(configFile,
+		[]byte(`{"serverUrl": "http://from-file:8801", "namespace": "ns-from-file", "output": "json"}`), 0600))
+
+	flags := &configFlags{configFile: configFile}
+	cfg, err := flags.resolve(func(string) string { return "" })
+	assert.Nil(t, err)
+	assert.Equal(t, "http://from-file:8801", cfg.ServerUrl)
+	assert.Equal(t, "ns-from-file", cfg.Namespace)
+	assert.Equal(t, outputJson, cfg.Output)
+	assert.Equal(t, xc.DefaultWorkerUrl, cfg.WorkerUrl)
+
+	flags = &configFlags{namespace: "ns-from-flag"}
+	cfg, err = flags.resolve(func(key string) string {
+		return map[string]string{
+			envConfig:    configFile,
+			envServerUrl: "http://from-env:8801",
+			envNamespace: "ns-from-env",
+		}[key]
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "http://from-env:8801", cfg.ServerUrl)
+	assert.Equal(t, "ns-from-flag", cfg.Namespace)
+
+	// the debug of the config file is overridden only when the flag is set explicitly
+	assert.Nil(t, os.WriteFile(configFile, []byte(`{"debug": true}`), 0600))
+	flags = &configFlags{configFile: configFile}
+	cfg, err = flags.resolve(func(string) string { return "" })
+	assert.Nil(t, err)
+	assert.True(t, cfg.Debug)
+	flags = &configFlags{configFile: configFile, set: map[string]bool{"debug": true}}
+	cfg, err = flags.resolve(func(string) string { return "" })
+	assert.Nil(t, err)
+	assert.False(t, cfg.Debug)
+
+	flags = &configFlags{configFile: filepath.Join(t.TempDir(), "missing.json")}
+	_, err = flags.resolve(func(string) string { return "" })
+	assert.NotNil(t, err)
+}
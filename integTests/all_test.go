@@ -105,3 +105,7 @@ func TestAnyOfTimerLocalQueueWithLocalQueueMessagesReceived(t *testing.T) {
 func TestAllOfTimerLocalQueue(t *testing.T) {
 	command_request.TestAllOfTimerLocalQueue(t, client)
 }
+
+func TestPublishToUndefinedLocalQueue(t *testing.T) {
+	command_request.TestPublishToUndefinedLocalQueue(t, client)
+}
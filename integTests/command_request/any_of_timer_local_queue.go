@@ -19,6 +19,12 @@ func (b AnyOfTimerLocalQProcess) GetAsyncStateSchema() xc.StateSchema {
 	return xc.NewStateSchema(&anyOfTimerLocalQState{})
 }
 
+func (b AnyOfTimerLocalQProcess) GetCommunicationSchema() xc.CommunicationSchema {
+	return xc.NewCommunicationSchema(
+		xc.NewLocalQueueDefWithMaxCount(testQueueName1, nil, 2),
+	)
+}
+
 type anyOfTimerLocalQState struct {
 	xc.AsyncStateDefaults
 }
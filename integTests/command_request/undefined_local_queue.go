@@ -0,0 +1,30 @@
+package command_request
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/sdk-go/integTests/common"
+	"github.com/xcherryio/sdk-go/xc"
+	"testing"
+)
+
+func TestPublishToUndefinedLocalQueue(t *testing.T, client xc.Client) {
+	prcId := common.GenerateProcessId()
+	prc := AnyOfTimerLocalQProcess{}
+	_, err := client.StartProcess(context.Background(), prc, prcId, "timer")
+	assert.Nil(t, err)
+
+	err = client.PublishToLocalQueue(context.Background(), prcId, "test-queue-undefined", "localQueue", nil)
+	assert.NotNil(t, err)
+	assert.False(t, xc.IsClientError(err))
+
+	err = client.BatchPublishToLocalQueue(context.Background(), prcId,
+		xc.LocalQueuePublishMessage{
+			QueueName: testQueueName1,
+		},
+		xc.LocalQueuePublishMessage{
+			QueueName: "test-queue-undefined",
+		})
+	assert.NotNil(t, err)
+	assert.False(t, xc.IsClientError(err))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/xcherryio/sdk-go/xc"
 	"log"
 	"net/http"
+	"strconv"
 )
 
 type worker struct {
@@ -20,6 +21,35 @@ func StartGinWorker(workerService xc.WorkerService) (closeFunc func()) {
 	router := gin.Default()
 	router.POST(xc.ApiPathAsyncStateWaitUntil, w.apiAsyncStateWaitUntil)
 	router.POST(xc.ApiPathAsyncStateExecute, w.apiAsyncStateExecute)
+	router.GET(xc.ApiPathHealth, w.apiHealth)
+	router.GET(xc.ApiPathReady, w.apiReady)
+	router.GET(xc.ApiPathRegistryInfo, w.apiRegistryInfo)
+
+	wfServer := &http.Server{
+		Addr:    ":" + xc.DefaultWorkerPort,
+		Handler: router,
+	}
+	go func() {
+		if err := wfServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("listen: %s\n", err)
+		}
+	}()
+	return func() { wfServer.Close() }
+}
+
+// StartGinWorkerWithRouter starts a worker serving the namespaces of the router on the namespaced API paths
+func StartGinWorkerWithRouter(workerServiceRouter xc.WorkerServiceRouter) (closeFunc func()) {
+	router := gin.Default()
+	for _, namespace := range workerServiceRouter.GetNamespaces() {
+		workerService, _ := workerServiceRouter.GetWorkerService(namespace)
+		w := worker{
+			workerService: workerService,
+		}
+		router.POST(xc.GetNamespacedApiPath(namespace, xc.ApiPathAsyncStateWaitUntil), w.apiAsyncStateWaitUntil)
+		router.POST(xc.GetNamespacedApiPath(namespace, xc.ApiPathAsyncStateExecute), w.apiAsyncStateExecute)
+		router.GET(xc.GetNamespacedApiPath(namespace, xc.ApiPathReady), w.apiReady)
+		router.GET(xc.GetNamespacedApiPath(namespace, xc.ApiPathRegistryInfo), w.apiRegistryInfo)
+	}
 
 	wfServer := &http.Server{
 		Addr:    ":" + xc.DefaultWorkerPort,
@@ -42,7 +72,7 @@ func (w worker) apiAsyncStateWaitUntil(c *gin.Context) {
 
 	resp, err := w.workerService.HandleAsyncStateWaitUntil(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		w.returnWorkerError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -56,8 +86,39 @@ func (w worker) apiAsyncStateExecute(c *gin.Context) {
 
 	resp, err := w.workerService.HandleAsyncStateExecute(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		w.returnWorkerError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
 }
+
+func (w worker) apiHealth(c *gin.Context) {
+	if !w.workerService.IsHealthy() {
+		c.Status(http.StatusServiceUnavailable)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+func (w worker) apiReady(c *gin.Context) {
+	if !w.workerService.IsReady() {
+		c.Status(http.StatusServiceUnavailable)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+func (w worker) apiRegistryInfo(c *gin.Context) {
+	c.JSON(http.StatusOK, w.workerService.GetRegistryInfo())
+}
+
+func (w worker) returnWorkerError(c *gin.Context, err error) {
+	if retryAfter, ok := xc.GetRetryAfterSeconds(err); ok {
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+	}
+	if xc.IsWorkerOverloadedError(err) {
+		c.JSON(http.StatusTooManyRequests, xc.NewWorkerErrorResponse(err))
+		return
+	}
+	c.JSON(http.StatusFailedDependency, xc.NewWorkerErrorResponse(err))
+}
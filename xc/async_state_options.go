@@ -1,6 +1,9 @@
 package xc
 
 import (
+	"context"
+	"time"
+
 	"github.com/xcherryio/apis/goapi/xcapi"
 )
 
@@ -16,6 +19,7 @@ type AsyncStateOptions struct {
 	// ExecuteTimeoutSeconds is the timeout for the execute API call.
 	// Default: 10 seconds(configurable in server) when set as 0
 	// It will be capped to 60 seconds by server (configurable in server)
+	// See ExternalTask for waiting for the work that takes longer in an external system
 	ExecuteTimeoutSeconds int32
 	// WaitUntilRetryPolicy is the retry policy for the waitUntil API call.
 	// Default: infinite retry with 1 second initial interval, 120 seconds max interval, and 2 backoff factor,
@@ -41,3 +45,25 @@ func (o *AsyncStateOptions) SetFailureRecoveryOption(destState AsyncState) *Asyn
 
 	return o
 }
+
+// getStateApiTimeout returns the timeout of the state API in AsyncStateOptions, or the defaultTimeout if not set
+func getStateApiTimeout(state AsyncState, api xcapi.WorkerApiType, defaultTimeout time.Duration) time.Duration {
+	options := state.GetStateOptions()
+	if options != nil {
+		if api == xcapi.WAIT_UNTIL_API && options.WaitUntilTimeoutSeconds > 0 {
+			return time.Duration(options.WaitUntilTimeoutSeconds) * time.Second
+		}
+		if api == xcapi.EXECUTE_API && options.ExecuteTimeoutSeconds > 0 {
+			return time.Duration(options.ExecuteTimeoutSeconds) * time.Second
+		}
+	}
+	return defaultTimeout
+}
+
+// withStateApiTimeout returns the Go context of the state API, without deadline if the timeout is 0
+func withStateApiTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -4,9 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
-	"net/url"
-	"reflect"
 
 	"github.com/google/uuid"
 	"github.com/xcherryio/apis/goapi/xcapi"
@@ -14,16 +11,14 @@ import (
 
 type basicClientImpl struct {
 	options   ClientOptions
-	apiClient *xcapi.APIClient
+	transport ClientTransport
 }
 
 func (u *basicClientImpl) DescribeCurrentProcessExecution(
 	ctx context.Context, processId string,
 ) (*xcapi.ProcessExecutionDescribeResponse, error) {
-	req := u.apiClient.DefaultAPI.ApiV1XcherryServiceProcessExecutionDescribePost(ctx)
-
 	reqObj := xcapi.ProcessExecutionDescribeRequest{
-		Namespace: u.options.Namespace,
+		Namespace: getNamespace(ctx, u.options.Namespace),
 		ProcessId: processId,
 	}
 
@@ -36,9 +31,9 @@ func (u *basicClientImpl) DescribeCurrentProcessExecution(
 		}()
 	}
 
-	resp, httpResp, httpErr := req.ProcessExecutionDescribeRequest(reqObj).Execute()
-	if err := u.processError(httpErr, httpResp); err != nil {
-		return nil, err
+	resp, httpErr = u.transport.DescribeProcessExecution(ctx, reqObj)
+	if httpErr != nil {
+		return nil, httpErr
 	}
 	return resp, nil
 }
@@ -50,7 +45,7 @@ func (u *basicClientImpl) StartProcess(
 	var encodedInput *xcapi.EncodedObject
 	if input != nil {
 		var err error
-		encodedInput, err = u.options.ObjectEncoder.Encode(input)
+		encodedInput, err = encodeObject(GetObjectEncoderForProcess(u.options.ObjectEncoder, processId), input)
 		if err != nil {
 			return "", err
 		}
@@ -80,12 +75,17 @@ func (u *basicClientImpl) StartProcess(
 		}
 	}
 
-	req := u.apiClient.DefaultAPI.ApiV1XcherryServiceProcessExecutionStartPost(ctx)
+	namespace := getNamespace(ctx, u.options.Namespace)
+	workerUrl := u.options.WorkerUrl
+	if u.options.EnableNamespacedWorkerUrl {
+		workerUrl = GetNamespacedWorkerUrl(workerUrl, namespace)
+	}
+
 	reqObj := xcapi.ProcessExecutionStartRequest{
-		Namespace:          u.options.Namespace,
+		Namespace:          namespace,
 		ProcessId:          processId,
 		ProcessType:        processType,
-		WorkerUrl:          u.options.WorkerUrl,
+		WorkerUrl:          workerUrl,
 		StartStateId:       startStateIdPtr,
 		StartStateInput:    encodedInput,
 		StartStateConfig:   startStateConfig,
@@ -100,9 +100,9 @@ func (u *basicClientImpl) StartProcess(
 			fmt.Println("ProcessExecutionStartRequest is responded", anyToJson(resp), anyToJson(httpErr))
 		}()
 	}
-	resp, httpResp, httpErr := req.ProcessExecutionStartRequest(reqObj).Execute()
-	if err := u.processError(httpErr, httpResp); err != nil {
-		return "", err
+	resp, httpErr = u.transport.StartProcessExecution(ctx, reqObj)
+	if httpErr != nil {
+		return "", httpErr
 	}
 	return resp.GetProcessExecutionId(), nil
 }
@@ -110,9 +110,8 @@ func (u *basicClientImpl) StartProcess(
 func (u *basicClientImpl) StopProcess(
 	ctx context.Context, processId string, stopType xcapi.ProcessExecutionStopType,
 ) error {
-	req := u.apiClient.DefaultAPI.ApiV1XcherryServiceProcessExecutionStopPost(ctx)
 	reqObj := xcapi.ProcessExecutionStopRequest{
-		Namespace: u.options.Namespace,
+		Namespace: getNamespace(ctx, u.options.Namespace),
 		ProcessId: processId,
 		StopType:  stopType.Ptr(),
 	}
@@ -124,12 +123,11 @@ func (u *basicClientImpl) StopProcess(
 			fmt.Println("ProcessExecutionStopRequest is responded", anyToJson(httpErr))
 		}()
 	}
-	httpResp, httpErr := req.ProcessExecutionStopRequest(reqObj).Execute()
-
-	if err := u.processError(httpErr, httpResp); err != nil {
-		return err
+	httpErr = u.transport.StopProcessExecution(ctx, reqObj)
+	if httpErr != nil {
+		return httpErr
 	}
-	return nil
+	return onProcessClosing(ctx, u.options.ObjectEncoder, processId)
 }
 
 func (u *basicClientImpl) PublishToLocalQueue(
@@ -145,10 +143,8 @@ func (u *basicClientImpl) PublishToLocalQueue(
 
 	}
 
-	req := u.apiClient.DefaultAPI.ApiV1XcherryServiceProcessExecutionPublishToLocalQueuePost(ctx)
-
 	reqObj := xcapi.PublishToLocalQueueRequest{
-		Namespace: u.options.Namespace,
+		Namespace: getNamespace(ctx, u.options.Namespace),
 		ProcessId: processId,
 		Messages:  messages,
 	}
@@ -161,39 +157,8 @@ func (u *basicClientImpl) PublishToLocalQueue(
 		}()
 	}
 
-	httpResp, httpErr := req.PublishToLocalQueueRequest(reqObj).Execute()
-	if err := u.processError(httpErr, httpResp); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (u *basicClientImpl) processError(err error, httpResp *http.Response) error {
-	if httpResp != nil {
-		defer httpResp.Body.Close()
-	}
-	if err == nil && httpResp != nil && httpResp.StatusCode == http.StatusOK {
-		return nil
-	}
-	if u.options.EnabledDebugLogging {
-		if err != nil {
-			uerr, ok := err.(*url.Error)
-			if ok {
-				fmt.Println("encounter url.Error", uerr.Err, uerr.Err.Error())
-				uet := reflect.TypeOf(uerr.Err)
-				fmt.Println("url.Error.Err type", uet.String(), uet.Name(), uet.Kind())
-			}
-		}
-	}
-	var resp *xcapi.ApiErrorResponse
-	oerr, ok := err.(*xcapi.GenericOpenAPIError)
-	if ok {
-		rsp, ok := oerr.Model().(xcapi.ApiErrorResponse)
-		if ok {
-			resp = &rsp
-		}
-	}
-	return NewApiError(err, oerr, httpResp, resp)
+	httpErr = u.transport.PublishToLocalQueue(ctx, reqObj)
+	return httpErr
 }
 
 func anyToJson(req any) string {
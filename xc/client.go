@@ -18,11 +18,18 @@ type Client interface {
 	StartProcessWithOptions(
 		ctx context.Context, definition Process, processId string, input interface{}, options *ProcessStartOptions,
 	) (string, error)
+	// StartProcessByType is the same as StartProcessWithOptions, but looks up the registered process by processType
+	StartProcessByType(
+		ctx context.Context, processType string, processId string, input interface{}, options *ProcessStartOptions,
+	) (string, error)
 	// StopProcess stops a process execution
 	// processId is the required business identifier for the process execution
 	StopProcess(ctx context.Context, processId string, stopType xcapi.ProcessExecutionStopType) error
 	// PublishToLocalQueue publishes a message to a local queue
 	// the payload can be empty(nil)
+	// the queueName and payload type are validated against the CommunicationSchema of the process type
+	// of the current process execution, if it's registered and declares any local queue.
+	// The payload can also be a *xcapi.EncodedObject that is already encoded, which is sent as is without validating the type
 	PublishToLocalQueue(
 		ctx context.Context, processId string, queueName string, payload interface{}, options *LocalQueuePublishOptions,
 	) error
@@ -34,6 +41,17 @@ type Client interface {
 	DescribeCurrentProcessExecution(
 		ctx context.Context, processId string,
 	) (*xcapi.ProcessExecutionDescribeResponse, error)
+	// CompleteExternalTask completes the ExternalTask of the token string with the result,
+	// which must be of the result type of the ExternalTask.
+	// CompleteExternalTask and FailExternalTask of the same token are deduplicated, only the first one takes effect
+	CompleteExternalTask(ctx context.Context, token string, result interface{}) error
+	// FailExternalTask fails the ExternalTask of the token string with the reason
+	FailExternalTask(ctx context.Context, token string, reason string) error
+	// HeartbeatExternalTask reports the ExternalTask of the token string is still running,
+	// with the optional details(e.g. the progress), which resets the heartbeat timeout.
+	// Each heartbeat wakes up the waiting state once, so send them at an interval well above a round of the state,
+	// e.g. a fraction of the heartbeat timeout
+	HeartbeatExternalTask(ctx context.Context, token string, details interface{}) error
 }
 
 // BasicClient is a base client without process registry
@@ -82,22 +100,13 @@ func NewClient(registry Registry, options *ClientOptions) Client {
 
 // NewBasicClient returns a BasicClient
 func NewBasicClient(options ClientOptions) BasicClient {
-
-	cfg := &xcapi.Configuration{
-		Servers: []xcapi.ServerConfiguration{
-			{
-				URL: options.ServerUrl,
-			},
-		},
+	transport := options.Transport
+	if transport == nil {
+		transport = NewRestClientTransport(options)
 	}
-	if options.EnabledDebugLogging {
-		cfg.Debug = true
-	}
-
-	apiClient := xcapi.NewAPIClient(cfg)
 
 	return &basicClientImpl{
 		options:   options,
-		apiClient: apiClient,
+		transport: transport,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/xcherryio/apis/goapi/xcapi"
 	"github.com/xcherryio/sdk-go/xc/ptr"
+	"time"
 )
 
 type clientImpl struct {
@@ -21,7 +22,12 @@ func (c *clientImpl) GetBasicClient() BasicClient {
 func (c *clientImpl) StartProcessWithOptions(
 	ctx context.Context, definition Process, processId string, input interface{}, startOptions *ProcessStartOptions,
 ) (string, error) {
-	prcType := GetFinalProcessType(definition)
+	return c.StartProcessByType(ctx, GetFinalProcessType(definition), processId, input, startOptions)
+}
+
+func (c *clientImpl) StartProcessByType(
+	ctx context.Context, prcType string, processId string, input interface{}, startOptions *ProcessStartOptions,
+) (string, error) {
 	prc := c.registry.getProcess(prcType)
 	if prc == nil {
 		return "", NewInvalidArgumentError("Process is not registered")
@@ -56,7 +62,10 @@ func (c *clientImpl) StartProcessWithOptions(
 					return "", NewInvalidArgumentError("invalid attribute key for local attribute schema: " + key)
 				}
 
-				encodedValPtr, err := GetDefaultObjectEncoder().Encode(attr)
+				encodedValPtr, err := encodeLocalAttribute(
+					persSchema.LocalAttributeSchema,
+					GetObjectEncoderForProcess(c.clientOptions.ObjectEncoder, processId),
+					key, attr, time.Now())
 				if err != nil {
 					return "", err
 				}
@@ -79,7 +88,11 @@ func (c *clientImpl) StartProcessWithOptions(
 func (c *clientImpl) PublishToLocalQueue(
 	ctx context.Context, processId string, queueName string, payload interface{}, options *LocalQueuePublishOptions,
 ) error {
-	msg, err := c.convertToAPIMessage(queueName, payload, options)
+	commSchema, err := c.getCommunicationSchemaOfProcess(ctx, processId)
+	if err != nil {
+		return err
+	}
+	msg, err := c.convertToAPIMessage(commSchema, processId, queueName, payload, options)
 	if err != nil {
 		return err
 	}
@@ -89,9 +102,13 @@ func (c *clientImpl) PublishToLocalQueue(
 func (c *clientImpl) BatchPublishToLocalQueue(
 	ctx context.Context, processId string, messages ...LocalQueuePublishMessage,
 ) error {
+	commSchema, err := c.getCommunicationSchemaOfProcess(ctx, processId)
+	if err != nil {
+		return err
+	}
 	var msgs []xcapi.LocalQueueMessage
 	for _, m := range messages {
-		msg, err := c.convertToAPIMessage(m.QueueName, m.Payload, &LocalQueuePublishOptions{
+		msg, err := c.convertToAPIMessage(commSchema, processId, m.QueueName, m.Payload, &LocalQueuePublishOptions{
 			DedupSeed: m.DedupSeed,
 			DedupUUID: m.DedupUUID,
 		})
@@ -104,12 +121,17 @@ func (c *clientImpl) BatchPublishToLocalQueue(
 }
 
 func (c *clientImpl) convertToAPIMessage(
-	queueName string, payload interface{}, options *LocalQueuePublishOptions,
+	commSchema CommunicationSchema, processId string, queueName string, payload interface{},
+	options *LocalQueuePublishOptions,
 ) (xcapi.LocalQueueMessage, error) {
+	if err := commSchema.ValidateLocalQueueMessage(queueName, payload); err != nil {
+		return xcapi.LocalQueueMessage{}, err
+	}
+
 	var pl *xcapi.EncodedObject
 	var err error
 	if payload != nil {
-		pl, err = c.clientOptions.ObjectEncoder.Encode(payload)
+		pl, err = encodeObject(GetObjectEncoderForProcess(c.clientOptions.ObjectEncoder, processId), payload)
 		if err != nil {
 			return xcapi.LocalQueueMessage{}, err
 		}
@@ -120,8 +142,7 @@ func (c *clientImpl) convertToAPIMessage(
 	}
 	if options != nil {
 		if options.DedupSeed != nil {
-			guid := uuid.NewMD5(uuid.NameSpaceOID, []byte(*options.DedupSeed))
-			msg.DedupId = ptr.Any(guid.String())
+			msg.DedupId = ptr.Any(GetDedupUUIDFromSeed(*options.DedupSeed))
 		}
 		if options.DedupUUID != nil {
 			_, err := uuid.Parse(*options.DedupUUID)
@@ -134,6 +155,27 @@ func (c *clientImpl) convertToAPIMessage(
 	return msg, nil
 }
 
+// getCommunicationSchemaOfProcess returns the CommunicationSchema of the registered process type
+// of the current process execution, to validate the messages to publish.
+// It's empty(no validation) when the process type is not registered or no local queue is declared by any process,
+// so that the process execution is only described when needed
+func (c *clientImpl) getCommunicationSchemaOfProcess(
+	ctx context.Context, processId string,
+) (CommunicationSchema, error) {
+	if !c.registry.isAnyLocalQueueDeclared() {
+		return CommunicationSchema{}, nil
+	}
+	resp, err := c.BasicClient.DescribeCurrentProcessExecution(ctx, processId)
+	if err != nil {
+		return CommunicationSchema{}, err
+	}
+	prcType := resp.GetProcessType()
+	if c.registry.getProcess(prcType) == nil {
+		return CommunicationSchema{}, nil
+	}
+	return c.registry.getCommunicationSchema(prcType), nil
+}
+
 func (c *clientImpl) StartProcess(
 	ctx context.Context, definition Process, processId string, input interface{},
 ) (string, error) {
@@ -151,3 +193,65 @@ func (c *clientImpl) DescribeCurrentProcessExecution(
 ) (*xcapi.ProcessExecutionDescribeResponse, error) {
 	return c.BasicClient.DescribeCurrentProcessExecution(ctx, processId)
 }
+
+func (c *clientImpl) CompleteExternalTask(ctx context.Context, token string, result interface{}) error {
+	t, err := ParseExternalTaskToken(token)
+	if err != nil {
+		return err
+	}
+	encoded, err := encodeObject(GetObjectEncoderForProcess(c.clientOptions.ObjectEncoder, t.ProcessId), result)
+	if err != nil {
+		return err
+	}
+	return c.publishExternalTaskCompletion(ctx, token, ExternalTaskCompletion{
+		Result: encoded,
+	})
+}
+
+func (c *clientImpl) FailExternalTask(ctx context.Context, token string, reason string) error {
+	return c.publishExternalTaskCompletion(ctx, token, ExternalTaskCompletion{
+		FailureReason: &reason,
+	})
+}
+
+func (c *clientImpl) HeartbeatExternalTask(ctx context.Context, token string, details interface{}) error {
+	t, err := ParseExternalTaskToken(token)
+	if err != nil {
+		return err
+	}
+	heartbeat := ExternalTaskHeartbeat{
+		TaskId: t.TaskId,
+		SentAt: time.Now().Unix(),
+	}
+	if details != nil {
+		heartbeat.Details, err = encodeObject(GetObjectEncoderForProcess(c.clientOptions.ObjectEncoder, t.ProcessId), details)
+		if err != nil {
+			return err
+		}
+	}
+	return c.PublishToLocalQueue(
+		getExternalTaskContext(ctx, t), t.ProcessId, getExternalTaskHeartbeatQueueName(t.TaskName), heartbeat, nil)
+}
+
+func (c *clientImpl) publishExternalTaskCompletion(
+	ctx context.Context, token string, completion ExternalTaskCompletion,
+) error {
+	t, err := ParseExternalTaskToken(token)
+	if err != nil {
+		return err
+	}
+	completion.TaskId = t.TaskId
+	// the same dedupId for completing and failing, so that only the first one is accepted
+	return c.PublishToLocalQueue(
+		getExternalTaskContext(ctx, t), t.ProcessId, getExternalTaskCompletionQueueName(t.TaskName), completion,
+		&LocalQueuePublishOptions{
+			DedupSeed: &token,
+		})
+}
+
+func getExternalTaskContext(ctx context.Context, token ExternalTaskToken) context.Context {
+	if token.Namespace == "" {
+		return ctx
+	}
+	return WithNamespace(ctx, token.Namespace)
+}
@@ -0,0 +1,65 @@
+package xc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+type approvalResult struct {
+	ApprovedAmount int
+}
+
+func TestCloseDecisionWithResult(t *testing.T) {
+	encoder := GetDefaultObjectEncoder()
+	decision, err := toApiDecision(CompleteProcessWithResult(approvalResult{ApprovedAmount: 100}), "", nil, encoder)
+	assert.Nil(t, err)
+	assert.Equal(t, xcapi.GRACEFUL_COMPLETE_PROCESS, decision.ThreadCloseDecision.CloseType)
+	var result approvalResult
+	assert.Nil(t, encoder.Decode(decision.ThreadCloseDecision.CloseInput, &result))
+	assert.Equal(t, 100, result.ApprovedAmount)
+
+	decision, err = toApiDecision(GracefulCompletingProcess, "", nil, encoder)
+	assert.Nil(t, err)
+	assert.Nil(t, decision.ThreadCloseDecision.CloseInput)
+
+	_, err = toApiDecision(&StateDecision{CloseResult: 1}, "", nil, encoder)
+	assert.NotNil(t, err)
+}
+
+type noLocalQueueTestProcess struct {
+	ProcessDefaults
+}
+
+func (p noLocalQueueTestProcess) GetAsyncStateSchema() StateSchema {
+	return NewStateSchema(&statefulTestState{})
+}
+
+func TestPublishToLocalQueueValidatedByProcessType(t *testing.T) {
+	registry := NewRegistry()
+	assert.Nil(t, registry.AddProcesses(externalTaskTestProcess{}, noLocalQueueTestProcess{}))
+	transport := &publishTestTransport{
+		processType: GetFinalProcessType(externalTaskTestProcess{}),
+	}
+	options := *GetLocalDefaultClientOptions()
+	options.Transport = transport
+	client := NewClient(registry, &options)
+	ctx := context.Background()
+
+	assert.Nil(t, client.PublishToLocalQueue(ctx, "process-1", testInferenceTask.GetHeartbeatQueueName(), nil, nil))
+	assert.Error(t, client.PublishToLocalQueue(ctx, "process-1", "undeclared", nil, nil))
+	assert.Error(t, client.BatchPublishToLocalQueue(ctx, "process-1",
+		LocalQueuePublishMessage{QueueName: testInferenceTask.GetHeartbeatQueueName()},
+		LocalQueuePublishMessage{QueueName: "undeclared"},
+	))
+
+	// the process type that declares no local queue is not validated
+	transport.processType = GetFinalProcessType(noLocalQueueTestProcess{})
+	assert.Nil(t, client.PublishToLocalQueue(ctx, "process-2", "undeclared", nil, nil))
+
+	transport.processType = "unregistered"
+	assert.Nil(t, client.PublishToLocalQueue(ctx, "process-3", "undeclared", nil, nil))
+	assert.Equal(t, 3, len(transport.requests))
+}
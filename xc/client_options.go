@@ -1,11 +1,18 @@
 package xc
 
 type ClientOptions struct {
-	Namespace           string
-	ServerUrl           string
-	WorkerUrl           string
-	ObjectEncoder       ObjectEncoder
-	EnabledDebugLogging bool
+	// Namespace is the namespace that the client calls target, which can be overridden per call by WithNamespace
+	Namespace string
+	ServerUrl string
+	WorkerUrl string
+	// EnableNamespacedWorkerUrl makes StartProcess use the worker url prefixed by the namespace of the call,
+	// for the worker serving multiple namespaces with WorkerServiceRouter, see GetNamespacedWorkerUrl
+	EnableNamespacedWorkerUrl bool
+	ObjectEncoder             ObjectEncoder
+	EnabledDebugLogging       bool
+	// Transport sends the requests to the server, e.g. the gRPC transport from package xcgrpc
+	// Default: the REST transport when set as nil
+	Transport ClientTransport
 	// DefaultProcessTimeoutSecondsOverride is used when StartProcess is called and
 	// 1. no timeout specified in ProcessOptions(default as zero)
 	// 2. no timeout specified in ProcessStartOptions(default as nil)
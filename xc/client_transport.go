@@ -0,0 +1,40 @@
+package xc
+
+import (
+	"context"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+// ClientTransport sends the requests of BasicClient to xCherry server.
+// The default one is the REST transport with the generated xcapi.APIClient.
+// See package xcgrpc for the gRPC transport
+type ClientTransport interface {
+	StartProcessExecution(
+		ctx context.Context, request xcapi.ProcessExecutionStartRequest,
+	) (*xcapi.ProcessExecutionStartResponse, error)
+	StopProcessExecution(ctx context.Context, request xcapi.ProcessExecutionStopRequest) error
+	DescribeProcessExecution(
+		ctx context.Context, request xcapi.ProcessExecutionDescribeRequest,
+	) (*xcapi.ProcessExecutionDescribeResponse, error)
+	PublishToLocalQueue(ctx context.Context, request xcapi.PublishToLocalQueueRequest) error
+}
+
+// NewRestClientTransport returns the REST ClientTransport that is used by default
+func NewRestClientTransport(options ClientOptions) ClientTransport {
+	cfg := &xcapi.Configuration{
+		Servers: []xcapi.ServerConfiguration{
+			{
+				URL: options.ServerUrl,
+			},
+		},
+	}
+	if options.EnabledDebugLogging {
+		cfg.Debug = true
+	}
+
+	return &restClientTransport{
+		apiClient:           xcapi.NewAPIClient(cfg),
+		enabledDebugLogging: options.EnabledDebugLogging,
+	}
+}
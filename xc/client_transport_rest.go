@@ -0,0 +1,82 @@
+package xc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+type restClientTransport struct {
+	apiClient           *xcapi.APIClient
+	enabledDebugLogging bool
+}
+
+func (t *restClientTransport) StartProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionStartRequest,
+) (*xcapi.ProcessExecutionStartResponse, error) {
+	req := t.apiClient.DefaultAPI.ApiV1XcherryServiceProcessExecutionStartPost(ctx)
+	resp, httpResp, httpErr := req.ProcessExecutionStartRequest(request).Execute()
+	if err := t.processError(httpErr, httpResp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (t *restClientTransport) StopProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionStopRequest,
+) error {
+	req := t.apiClient.DefaultAPI.ApiV1XcherryServiceProcessExecutionStopPost(ctx)
+	httpResp, httpErr := req.ProcessExecutionStopRequest(request).Execute()
+	return t.processError(httpErr, httpResp)
+}
+
+func (t *restClientTransport) DescribeProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionDescribeRequest,
+) (*xcapi.ProcessExecutionDescribeResponse, error) {
+	req := t.apiClient.DefaultAPI.ApiV1XcherryServiceProcessExecutionDescribePost(ctx)
+	resp, httpResp, httpErr := req.ProcessExecutionDescribeRequest(request).Execute()
+	if err := t.processError(httpErr, httpResp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (t *restClientTransport) PublishToLocalQueue(
+	ctx context.Context, request xcapi.PublishToLocalQueueRequest,
+) error {
+	req := t.apiClient.DefaultAPI.ApiV1XcherryServiceProcessExecutionPublishToLocalQueuePost(ctx)
+	httpResp, httpErr := req.PublishToLocalQueueRequest(request).Execute()
+	return t.processError(httpErr, httpResp)
+}
+
+func (t *restClientTransport) processError(err error, httpResp *http.Response) error {
+	if httpResp != nil {
+		defer httpResp.Body.Close()
+	}
+	if err == nil && httpResp != nil && httpResp.StatusCode == http.StatusOK {
+		return nil
+	}
+	if t.enabledDebugLogging {
+		if err != nil {
+			uerr, ok := err.(*url.Error)
+			if ok {
+				fmt.Println("encounter url.Error", uerr.Err, uerr.Err.Error())
+				uet := reflect.TypeOf(uerr.Err)
+				fmt.Println("url.Error.Err type", uet.String(), uet.Name(), uet.Kind())
+			}
+		}
+	}
+	var resp *xcapi.ApiErrorResponse
+	oerr, ok := err.(*xcapi.GenericOpenAPIError)
+	if ok {
+		rsp, ok := oerr.Model().(xcapi.ApiErrorResponse)
+		if ok {
+			resp = &rsp
+		}
+	}
+	return NewApiError(err, oerr, httpResp, resp)
+}
@@ -6,6 +6,9 @@ type (
 	CommandType string
 
 	Command struct {
+		// CommandId is the optional identifier of the command to look up the result in CommandResults
+		// Default: empty, the result can only be looked up by the index
+		CommandId         string
 		CommandType       CommandType
 		TimerCommand      *TimerCommand
 		LocalQueueCommand *LocalQueueCommand
@@ -44,3 +47,12 @@ func NewLocalQueueCommand(queueName string, count int) Command {
 		},
 	}
 }
+
+// WithId returns a copy of the command with the CommandId.
+// NOTE: the server doesn't return the commandIds in the command results, so the WorkerService keeps the
+// CommandRequest with commandIds in a local queue of the SDK and maps the results to the commands by their positions,
+// which takes an extra state execution of waiting before the Execute, see CommandRequest for details
+func (c Command) WithId(commandId string) Command {
+	c.CommandId = commandId
+	return c
+}
@@ -2,11 +2,36 @@ package xc
 
 import "github.com/xcherryio/apis/goapi/xcapi"
 
+// NOfCompletion is the CommandWaitingType to wait for CompletionCount of the commands/nested requests to complete
+// It is only for the SDK, the CommandRequest will be converted to ANY_OF_COMPLETION or ALL_OF_COMPLETION if possible,
+// otherwise it's waited by the SDK, see CommandRequest
+const NOfCompletion xcapi.CommandWaitingType = "NOfCompletion"
+
+// CommandRequest is the commands for the WaitUntil to wait for.
+// A CommandRequest with any CommandId, or with a combination that the server doesn't support(e.g. AllOf nested in
+// AnyOf, or NOf other than 1 or all), is waited by the SDK rather than the server: the WorkerService waits for any of
+// the commands in rounds, moving to the same state with the results so far until the CommandRequest is satisfied,
+// then invokes the Execute with the results of all the commands. So the Execute is invoked in a later state execution
+// than the WaitUntil.
 type CommandRequest struct {
-	Commands           []Command
+	Commands []Command
+	// NestedRequests are the nested combinations of commands, e.g. AnyOfConditions(AllOf(q1, q2), timer)
+	// When converting to the server's command request, the commands of nested requests are appended after Commands
+	NestedRequests     []*CommandRequest
 	CommandWaitingType xcapi.CommandWaitingType
+	// CompletionCount is the number of commands/nested requests to complete, only for NOfCompletion
+	CompletionCount int
+}
+
+// CommandCondition is a Command or a CommandRequest, which can be combined by AnyOfConditions, AllOfConditions and NOf
+type CommandCondition interface {
+	isCommandCondition()
 }
 
+func (c Command) isCommandCondition() {}
+
+func (r *CommandRequest) isCommandCondition() {}
+
 // EmptyCommandRequest will jump to decide stage immediately.
 func EmptyCommandRequest() *CommandRequest {
 	return &CommandRequest{
@@ -29,3 +54,168 @@ func AllOf(commands ...Command) *CommandRequest {
 		CommandWaitingType: xcapi.ALL_OF_COMPLETION,
 	}
 }
+
+// AnyOfConditions will wait for any of the commands or nested requests to complete,
+// e.g. AnyOfConditions(AllOf(q1, q2), timer)
+func AnyOfConditions(conditions ...CommandCondition) *CommandRequest {
+	return newCommandRequest(xcapi.ANY_OF_COMPLETION, 0, conditions)
+}
+
+// AllOfConditions will wait for all the commands and nested requests to complete
+func AllOfConditions(conditions ...CommandCondition) *CommandRequest {
+	return newCommandRequest(xcapi.ALL_OF_COMPLETION, 0, conditions)
+}
+
+// NOf will wait for n of the commands(or nested requests) to complete
+func NOf(n int, conditions ...CommandCondition) *CommandRequest {
+	return newCommandRequest(NOfCompletion, n, conditions)
+}
+
+func newCommandRequest(
+	waitingType xcapi.CommandWaitingType, completionCount int, conditions []CommandCondition,
+) *CommandRequest {
+	req := &CommandRequest{
+		CommandWaitingType: waitingType,
+		CompletionCount:    completionCount,
+	}
+	for _, cond := range conditions {
+		switch c := cond.(type) {
+		case Command:
+			req.Commands = append(req.Commands, c)
+		case *CommandRequest:
+			req.NestedRequests = append(req.NestedRequests, c)
+		}
+	}
+	return req
+}
+
+// IsSatisfied evaluates whether the CommandRequest is satisfied by the command results.
+// The results are matched with the commands in the same order as the request is sent to server,
+// which is the Commands first, then the commands of NestedRequests.
+// It's used by the WorkerService to wait for the CommandRequest that the server doesn't support,
+// and useful for testing the combination.
+func (r *CommandRequest) IsSatisfied(results CommandResults) bool {
+	timerIndex, localQIndex := 0, 0
+	return r.isSatisfied(results, &timerIndex, &localQIndex)
+}
+
+func (r *CommandRequest) isSatisfied(results CommandResults, timerIndex, localQIndex *int) bool {
+	var completed []bool
+	for _, cmd := range r.Commands {
+		status := xcapi.WAITING_COMMAND
+		switch cmd.CommandType {
+		case CommandTypeTimer:
+			if *timerIndex < len(results.TimerResults) {
+				status = results.TimerResults[*timerIndex].Status
+			}
+			*timerIndex++
+		case CommandTypeLocalQueue:
+			if *localQIndex < len(results.LocalQueueResults) {
+				status = results.LocalQueueResults[*localQIndex].GetStatus()
+			}
+			*localQIndex++
+		}
+		completed = append(completed, status == xcapi.COMPLETED_COMMAND)
+	}
+	for _, nested := range r.NestedRequests {
+		completed = append(completed, nested.isSatisfied(results, timerIndex, localQIndex))
+	}
+
+	count := 0
+	for _, c := range completed {
+		if c {
+			count++
+		}
+	}
+	switch r.CommandWaitingType {
+	case xcapi.ANY_OF_COMPLETION:
+		return count > 0
+	case xcapi.ALL_OF_COMPLETION:
+		return count == len(completed)
+	case NOfCompletion:
+		return count >= r.CompletionCount
+	default:
+		return true
+	}
+}
+
+// validate validates the completion counts of NOf
+func (r *CommandRequest) validate() error {
+	if r.CommandWaitingType == NOfCompletion {
+		total := len(r.Commands) + len(r.NestedRequests)
+		if r.CompletionCount <= 0 || r.CompletionCount > total {
+			return NewProcessDefinitionError(
+				"invalid completion count %v for %v commands/nested requests", r.CompletionCount, total)
+		}
+	}
+	for _, nested := range r.NestedRequests {
+		if err := nested.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flatten converts the CommandRequest into a flat list of commands with a single waiting type that server supports.
+// It returns false if the combination is not supported by the server, which must be waited by the SDK
+func (r *CommandRequest) flatten() ([]Command, xcapi.CommandWaitingType, bool) {
+	if len(r.Commands) == 0 && len(r.NestedRequests) == 1 && r.CommandWaitingType != NOfCompletion {
+		// AnyOf(x) and AllOf(x) are the same as x
+		return r.NestedRequests[0].flatten()
+	}
+	waitingType, ok := r.getServerWaitingType()
+	if !ok {
+		return nil, "", false
+	}
+	commands := r.Commands
+	for _, nested := range r.NestedRequests {
+		nestedCommands, nestedType, ok := nested.flatten()
+		if !ok {
+			return nil, "", false
+		}
+		if len(nestedCommands) == 0 {
+			continue
+		}
+		if len(nestedCommands) > 1 && nestedType != waitingType {
+			return nil, "", false
+		}
+		commands = append(commands, nestedCommands...)
+	}
+	if len(commands) == 0 {
+		return nil, xcapi.EMPTY_COMMAND, true
+	}
+	return commands, waitingType, true
+}
+
+func (r *CommandRequest) getServerWaitingType() (xcapi.CommandWaitingType, bool) {
+	if r.CommandWaitingType != NOfCompletion {
+		return r.CommandWaitingType, true
+	}
+	total := len(r.Commands) + len(r.NestedRequests)
+	if r.CompletionCount == 1 {
+		return xcapi.ANY_OF_COMPLETION, true
+	}
+	if r.CompletionCount == total {
+		return xcapi.ALL_OF_COMPLETION, true
+	}
+	return "", false
+}
+
+// getCommands returns all the commands in the same order as IsSatisfied matches the results,
+// which is the Commands first, then the commands of NestedRequests
+func (r *CommandRequest) getCommands() []Command {
+	commands := append([]Command{}, r.Commands...)
+	for _, nested := range r.NestedRequests {
+		commands = append(commands, nested.getCommands()...)
+	}
+	return commands
+}
+
+func (r *CommandRequest) hasCommandIds() bool {
+	for _, cmd := range r.getCommands() {
+		if cmd.CommandId != "" {
+			return true
+		}
+	}
+	return false
+}
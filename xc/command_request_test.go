@@ -0,0 +1,105 @@
+package xc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+func TestCommandRequestIsSatisfied(t *testing.T) {
+	// both managers approve OR 48h timeout
+	req := AnyOfConditions(
+		NewTimerCommand(time.Hour*48),
+		AllOf(
+			NewLocalQueueCommand("manager-1", 1),
+			NewLocalQueueCommand("manager-2", 1),
+		),
+	)
+
+	results := func(timer xcapi.CommandStatus, q1, q2 xcapi.CommandStatus) CommandResults {
+		return CommandResults{
+			TimerResults: []TimerResult{{Status: timer}},
+			LocalQueueResults: []LocalQueueCommandResult{
+				{Result: xcapi.LocalQueueResult{Status: q1}},
+				{Result: xcapi.LocalQueueResult{Status: q2}},
+			},
+		}
+	}
+
+	assert.False(t, req.IsSatisfied(results(xcapi.WAITING_COMMAND, xcapi.COMPLETED_COMMAND, xcapi.WAITING_COMMAND)))
+	assert.True(t, req.IsSatisfied(results(xcapi.WAITING_COMMAND, xcapi.COMPLETED_COMMAND, xcapi.COMPLETED_COMMAND)))
+	assert.True(t, req.IsSatisfied(results(xcapi.COMPLETED_COMMAND, xcapi.WAITING_COMMAND, xcapi.WAITING_COMMAND)))
+
+	twoOfThree := NOf(2,
+		NewLocalQueueCommand("q1", 1),
+		NewLocalQueueCommand("q2", 1),
+		NewLocalQueueCommand("q3", 1),
+	)
+	assert.False(t, twoOfThree.IsSatisfied(CommandResults{
+		LocalQueueResults: []LocalQueueCommandResult{
+			{Result: xcapi.LocalQueueResult{Status: xcapi.COMPLETED_COMMAND}},
+		},
+	}))
+	assert.True(t, twoOfThree.IsSatisfied(CommandResults{
+		LocalQueueResults: []LocalQueueCommandResult{
+			{Result: xcapi.LocalQueueResult{Status: xcapi.COMPLETED_COMMAND}},
+			{Result: xcapi.LocalQueueResult{Status: xcapi.WAITING_COMMAND}},
+			{Result: xcapi.LocalQueueResult{Status: xcapi.COMPLETED_COMMAND}},
+		},
+	}))
+}
+
+func TestToApiCommandRequestWithNestedRequests(t *testing.T) {
+	req, err := toApiCommandRequest(AnyOfConditions(
+		NewTimerCommand(time.Second),
+		AnyOf(NewLocalQueueCommand("q1", 1), NewLocalQueueCommand("q2", 1)),
+	), NewEmptyCommunicationSchema())
+	assert.Nil(t, err)
+	assert.Equal(t, xcapi.ANY_OF_COMPLETION, req.WaitingType)
+	assert.Equal(t, 1, len(req.TimerCommands))
+	assert.Equal(t, 2, len(req.LocalQueueCommands))
+
+	req, err = toApiCommandRequest(NOf(3,
+		NewTimerCommand(time.Second),
+		AllOf(NewLocalQueueCommand("q1", 1)),
+		NewLocalQueueCommand("q2", 1),
+	), NewEmptyCommunicationSchema())
+	assert.Nil(t, err)
+	assert.Equal(t, xcapi.ALL_OF_COMPLETION, req.WaitingType)
+
+	_, err = toApiCommandRequest(NOf(0, NewTimerCommand(time.Second)), NewEmptyCommunicationSchema())
+	assert.NotNil(t, err)
+}
+
+func TestCommandRequestWaitedBySDK(t *testing.T) {
+	assert.False(t, needsCommandWaiting(AnyOf(NewTimerCommand(time.Second), NewLocalQueueCommand("q1", 1))))
+	assert.False(t, needsCommandWaiting(EmptyCommandRequest()))
+
+	mixed := AnyOfConditions(
+		NewTimerCommand(time.Second),
+		AllOf(NewLocalQueueCommand("q1", 1), NewLocalQueueCommand("q2", 1)),
+	)
+	twoOfThree := NOf(2,
+		NewLocalQueueCommand("q1", 1),
+		NewLocalQueueCommand("q2", 1),
+		NewLocalQueueCommand("q3", 1),
+	)
+	for _, req := range []*CommandRequest{mixed, twoOfThree} {
+		assert.True(t, needsCommandWaiting(req))
+		// the server waits for any of the commands, plus the internal queue to pass the request to the Execute
+		apiReq, msg, err := startCommandWaiting(req, NewEmptyCommunicationSchema(), "state1-1", time.Now())
+		assert.Nil(t, err)
+		assert.Equal(t, xcapi.ANY_OF_COMPLETION, apiReq.WaitingType)
+		assert.Equal(t, len(req.getCommands())+1, len(apiReq.TimerCommands)+len(apiReq.LocalQueueCommands))
+		assert.Equal(t, getInternalCommandRequestQueueName("state1-1"), msg.QueueName)
+	}
+
+	_, _, err := startCommandWaiting(NOf(4,
+		NewLocalQueueCommand("q1", 1),
+		NewLocalQueueCommand("q2", 1),
+		NewLocalQueueCommand("q3", 1),
+	), NewEmptyCommunicationSchema(), "state1-1", time.Now())
+	assert.NotNil(t, err)
+}
@@ -8,30 +8,111 @@ type CommandResults struct {
 }
 
 type TimerResult struct {
-	Status xcapi.CommandStatus
+	// CommandId is the id of the TimerCommand, empty if the command has no CommandId
+	CommandId string
+	Status    xcapi.CommandStatus
 }
 
 type LocalQueueCommandResult struct {
-	Result  xcapi.LocalQueueResult
-	Encoder ObjectEncoder
+	// CommandId is the id of the LocalQueueCommand, empty if the command has no CommandId
+	CommandId string
+	Result    xcapi.LocalQueueResult
+	Encoder   ObjectEncoder
 }
 
 func (c CommandResults) GetFirstTimerStatus() xcapi.CommandStatus {
 	return c.GetTimerStatus(0)
 }
 
+// GetTimerStatus returns the status of the index-th TimerCommand, or empty status if the index is out of range
 func (c CommandResults) GetTimerStatus(index int) xcapi.CommandStatus {
-	cmd := c.TimerResults[index]
-	return cmd.Status
+	if index < 0 || index >= len(c.TimerResults) {
+		return ""
+	}
+	return c.TimerResults[index].Status
 }
 
 func (c CommandResults) GetFirstLocalQueueCommand() LocalQueueCommandResult {
 	return c.GetLocalQueueCommand(0)
 }
 
+// GetLocalQueueCommand returns the result of the index-th LocalQueueCommand,
+// or an empty result without status and messages if the index is out of range
 func (c CommandResults) GetLocalQueueCommand(index int) LocalQueueCommandResult {
-	cmd := c.LocalQueueResults[index]
-	return cmd
+	if index < 0 || index >= len(c.LocalQueueResults) {
+		return LocalQueueCommandResult{}
+	}
+	return c.LocalQueueResults[index]
+}
+
+// GetTimerResultById returns the result of the TimerCommand with the commandId
+// return false if not found
+func (c CommandResults) GetTimerResultById(commandId string) (TimerResult, bool) {
+	for _, r := range c.TimerResults {
+		if commandId != "" && r.CommandId == commandId {
+			return r, true
+		}
+	}
+	return TimerResult{}, false
+}
+
+// GetLocalQueueResultById returns the result of the LocalQueueCommand with the commandId
+// return false if not found
+func (c CommandResults) GetLocalQueueResultById(commandId string) (LocalQueueCommandResult, bool) {
+	for _, r := range c.LocalQueueResults {
+		if commandId != "" && r.CommandId == commandId {
+			return r, true
+		}
+	}
+	return LocalQueueCommandResult{}, false
+}
+
+// getLocalQueueResultByQueueName returns the result of the first LocalQueueCommand of the queue
+func (c CommandResults) getLocalQueueResultByQueueName(queueName string) (LocalQueueCommandResult, bool) {
+	for _, r := range c.LocalQueueResults {
+		if r.GetQueueName() == queueName {
+			return r, true
+		}
+	}
+	return LocalQueueCommandResult{}, false
+}
+
+// GetCommandStatusById returns the status of the command with the commandId, regardless of the command type
+// return false if not found
+func (c CommandResults) GetCommandStatusById(commandId string) (xcapi.CommandStatus, bool) {
+	if r, ok := c.GetTimerResultById(commandId); ok {
+		return r.Status, true
+	}
+	if r, ok := c.GetLocalQueueResultById(commandId); ok {
+		return r.GetStatus(), true
+	}
+	return "", false
+}
+
+// IsCommandFired returns true if the command with the commandId is completed
+func (c CommandResults) IsCommandFired(commandId string) bool {
+	status, ok := c.GetCommandStatusById(commandId)
+	return ok && status == xcapi.COMPLETED_COMMAND
+}
+
+// FiredCommandIds returns the ids of all the completed commands, timers first and then local queues
+func (c CommandResults) FiredCommandIds() []string {
+	var ids []string
+	for _, r := range c.TimerResults {
+		if r.CommandId != "" && r.Status == xcapi.COMPLETED_COMMAND {
+			ids = append(ids, r.CommandId)
+		}
+	}
+	for _, r := range c.LocalQueueResults {
+		if r.CommandId != "" && r.GetStatus() == xcapi.COMPLETED_COMMAND {
+			ids = append(ids, r.CommandId)
+		}
+	}
+	return ids
+}
+
+func (lc LocalQueueCommandResult) GetCommandId() string {
+	return lc.CommandId
 }
 
 func (lc LocalQueueCommandResult) GetStatus() xcapi.CommandStatus {
@@ -43,13 +124,21 @@ func (lc LocalQueueCommandResult) GetQueueName() string {
 }
 
 func (lc LocalQueueCommandResult) GetFirstMessage(ptr interface{}) {
-	msg := lc.Result.GetMessages()[0]
-	err := lc.Encoder.Decode(msg.Payload, ptr)
+	err := lc.GetFirstMessageE(ptr)
 	if err != nil {
 		panic(err)
 	}
 }
 
+// GetFirstMessageE is the same as GetFirstMessage, but returns error instead of panic
+func (lc LocalQueueCommandResult) GetFirstMessageE(ptr interface{}) error {
+	msgs := lc.Result.GetMessages()
+	if len(msgs) == 0 {
+		return NewInvalidArgumentError("no message is received from local queue %v", lc.GetQueueName())
+	}
+	return lc.Encoder.Decode(msgs[0].Payload, ptr)
+}
+
 func (lc LocalQueueCommandResult) GetMessages() []Object {
 	msgs := lc.Result.GetMessages()
 	ret := make([]Object, len(msgs))
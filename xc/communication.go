@@ -5,7 +5,10 @@ import "github.com/xcherryio/apis/goapi/xcapi"
 type Communication interface {
 	// PublishToLocalQueue publishes a message to a local queue
 	// the payload can be empty(nil)
+	// panics if the queue is not defined in the CommunicationSchema, or the payload fails to encode
 	PublishToLocalQueue(queueName string, payload interface{})
+	// PublishToLocalQueueE is the same as PublishToLocalQueue, but returns error instead of panic
+	PublishToLocalQueueE(queueName string, payload interface{}) error
 
 	// below is for internal implementation
 	communicationInternal
@@ -4,26 +4,45 @@ import "github.com/xcherryio/apis/goapi/xcapi"
 
 type communicationImpl struct {
 	encoder                     ObjectEncoder
+	schema                      CommunicationSchema
 	localQueueMessagesToPublish []xcapi.LocalQueueMessage
 }
 
 func NewCommunication(encoder ObjectEncoder) Communication {
+	return NewCommunicationWithSchema(encoder, NewEmptyCommunicationSchema())
+}
+
+// NewCommunicationWithSchema returns a Communication that validates the local queue messages against the schema
+func NewCommunicationWithSchema(encoder ObjectEncoder, schema CommunicationSchema) Communication {
 	return &communicationImpl{
 		encoder:                     encoder,
+		schema:                      schema,
 		localQueueMessagesToPublish: nil,
 	}
 }
 
 func (c *communicationImpl) PublishToLocalQueue(queueName string, payload interface{}) {
-	pl, err := c.encoder.Encode(payload)
+	err := c.PublishToLocalQueueE(queueName, payload)
 	if err != nil {
 		panic(err)
 	}
+}
+
+func (c *communicationImpl) PublishToLocalQueueE(queueName string, payload interface{}) error {
+	err := c.schema.ValidateLocalQueueMessage(queueName, payload)
+	if err != nil {
+		return err
+	}
+	pl, err := c.encoder.Encode(payload)
+	if err != nil {
+		return err
+	}
 	msg := xcapi.LocalQueueMessage{
 		QueueName: queueName,
 		Payload:   pl,
 	}
 	c.localQueueMessagesToPublish = append(c.localQueueMessagesToPublish, msg)
+	return nil
 }
 
 func (c *communicationImpl) GetLocalQueueMessagesToPublish() []xcapi.LocalQueueMessage {
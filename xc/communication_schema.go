@@ -0,0 +1,113 @@
+package xc
+
+import (
+	"reflect"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+type CommunicationSchema struct {
+	// LocalQueues is the queue name to the local queue definition
+	// LocalQueues are the queues that are specific to a process execution
+	LocalQueues map[string]LocalQueueDef
+}
+
+type LocalQueueDef struct {
+	QueueName string
+	// PayloadType is the Go type of the payload of the messages
+	// nil means the payload type is not validated
+	PayloadType reflect.Type
+	// MaxCount is the maximum number of messages that a LocalQueueCommand can wait for
+	// Default: 0, means no limit
+	MaxCount int
+}
+
+func NewEmptyCommunicationSchema() CommunicationSchema {
+	return CommunicationSchema{}
+}
+
+// NewCommunicationSchema creates a new CommunicationSchema
+// localQueues are the definitions of the local queues of the process
+func NewCommunicationSchema(localQueues ...LocalQueueDef) CommunicationSchema {
+	queues := map[string]LocalQueueDef{}
+	for _, def := range localQueues {
+		if _, ok := queues[def.QueueName]; ok {
+			panic("duplicate local queue name " + def.QueueName)
+		}
+		queues[def.QueueName] = def
+	}
+	return CommunicationSchema{
+		LocalQueues: queues,
+	}
+}
+
+// NewLocalQueueDef creates a new LocalQueueDef
+// payloadObj is an instance of the payload type, e.g. MyMessage{}, or nil to skip validating the payload type
+func NewLocalQueueDef(queueName string, payloadObj interface{}) LocalQueueDef {
+	return NewLocalQueueDefWithMaxCount(queueName, payloadObj, 0)
+}
+
+func NewLocalQueueDefWithMaxCount(queueName string, payloadObj interface{}, maxCount int) LocalQueueDef {
+	var payloadType reflect.Type
+	if payloadObj != nil {
+		payloadType = reflect.TypeOf(payloadObj)
+	}
+	return LocalQueueDef{
+		QueueName:   queueName,
+		PayloadType: payloadType,
+		MaxCount:    maxCount,
+	}
+}
+
+// IsLocalQueueDeclared returns true if any local queue is declared in the schema.
+// When no local queue is declared, the queue names and payload types are not validated
+func (s CommunicationSchema) IsLocalQueueDeclared() bool {
+	return len(s.LocalQueues) > 0
+}
+
+// ValidateLocalQueueMessage validates the queue name and the payload type of a message to publish
+func (s CommunicationSchema) ValidateLocalQueueMessage(queueName string, payload interface{}) error {
+	if !s.IsLocalQueueDeclared() {
+		return nil
+	}
+	def, ok := s.LocalQueues[queueName]
+	if !ok {
+		return NewInvalidArgumentError("local queue is not defined in the CommunicationSchema: %v", queueName)
+	}
+	return def.validatePayload(payload)
+}
+
+// ValidateLocalQueueCommand validates the queue name and the count of a LocalQueueCommand
+func (s CommunicationSchema) ValidateLocalQueueCommand(command LocalQueueCommand) error {
+	if !s.IsLocalQueueDeclared() {
+		return nil
+	}
+	def, ok := s.LocalQueues[command.QueueName]
+	if !ok {
+		return NewInvalidArgumentError("local queue is not defined in the CommunicationSchema: %v", command.QueueName)
+	}
+	if def.MaxCount > 0 && command.Count > def.MaxCount {
+		return NewInvalidArgumentError("local queue %v command count %v exceeds the max count %v",
+			command.QueueName, command.Count, def.MaxCount)
+	}
+	return nil
+}
+
+func (d LocalQueueDef) validatePayload(payload interface{}) error {
+	if d.PayloadType == nil || payload == nil {
+		return nil
+	}
+	if _, ok := payload.(*xcapi.EncodedObject); ok {
+		// already encoded, the type is not known
+		return nil
+	}
+	pt := reflect.TypeOf(payload)
+	if pt == d.PayloadType {
+		return nil
+	}
+	if pt.Kind() == reflect.Pointer && pt.Elem() == d.PayloadType {
+		return nil
+	}
+	return NewInvalidArgumentError("local queue %v expects payload type %v but got %v",
+		d.QueueName, d.PayloadType.String(), pt.String())
+}
@@ -0,0 +1,38 @@
+package xc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testQueueMessage struct {
+	Str string
+}
+
+func TestCommunicationSchemaValidateLocalQueueMessage(t *testing.T) {
+	schema := NewCommunicationSchema(
+		NewLocalQueueDef("q1", testQueueMessage{}),
+		NewLocalQueueDef("q2", nil),
+	)
+
+	assert.Nil(t, schema.ValidateLocalQueueMessage("q1", testQueueMessage{}))
+	assert.Nil(t, schema.ValidateLocalQueueMessage("q1", &testQueueMessage{}))
+	assert.Nil(t, schema.ValidateLocalQueueMessage("q1", nil))
+	assert.NotNil(t, schema.ValidateLocalQueueMessage("q1", "str"))
+	assert.Nil(t, schema.ValidateLocalQueueMessage("q2", 123))
+	assert.NotNil(t, schema.ValidateLocalQueueMessage("q3", nil))
+
+	// nothing is validated when no local queue is declared
+	assert.Nil(t, NewEmptyCommunicationSchema().ValidateLocalQueueMessage("q3", nil))
+}
+
+func TestCommunicationSchemaValidateLocalQueueCommand(t *testing.T) {
+	schema := NewCommunicationSchema(
+		NewLocalQueueDefWithMaxCount("q1", nil, 2),
+	)
+
+	assert.Nil(t, schema.ValidateLocalQueueCommand(*NewLocalQueueCommand("q1", 2).LocalQueueCommand))
+	assert.NotNil(t, schema.ValidateLocalQueueCommand(*NewLocalQueueCommand("q1", 3).LocalQueueCommand))
+	assert.NotNil(t, schema.ValidateLocalQueueCommand(*NewLocalQueueCommand("q2", 1).LocalQueueCommand))
+}
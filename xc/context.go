@@ -1,26 +1,99 @@
 package xc
 
-import "github.com/xcherryio/apis/goapi/xcapi"
+import (
+	"context"
+	"time"
 
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+// Context is the context of a state API invocation.
+// It embeds a Go context.Context, which is cancelled when the API timeout elapses or the request from server
+// is aborted, so it can be passed to the outbound calls like DB/HTTP requests. The API timeout is
+// WaitUntilTimeoutSeconds/ExecuteTimeoutSeconds of AsyncStateOptions, or WorkerOptions.DefaultStateApiTimeout.
 type Context interface {
+	context.Context
+
 	GetAttempt() int
 	GetProcessId() string
+	GetProcessExecutionId() string
+	GetProcessType() string
+	// GetNamespace returns the namespace of the WorkerService that handles the request
+	GetNamespace() string
+	GetStateId() string
+	// GetStateExecutionId returns the state execution id, which is the stateId + sequence number
+	GetStateExecutionId() string
+	GetProcessStartTime() time.Time
+	// GetFirstAttemptStartTime returns the start time of the first attempt of the current state API(during backoff retry)
+	GetFirstAttemptStartTime() time.Time
 	GetRecoverFromStateExecutionId() *string
 	GetRecoverFromStateApi() *xcapi.WorkerApiType
+	// GetServices returns the ServiceContainer from WorkerOptions, see GetService to look up a service
+	GetServices() *ServiceContainer
 }
 
-func newContext(ctx xcapi.Context) Context {
-	return &contextImpl{ctx: ctx}
+func newContext(
+	goCtx context.Context, ctx xcapi.Context, prcType, stateId, namespace string, services *ServiceContainer,
+) Context {
+	return &contextImpl{
+		Context:   goCtx,
+		ctx:       ctx,
+		prcType:   prcType,
+		stateId:   stateId,
+		namespace: namespace,
+		services:  services,
+	}
+}
+
+// NewTestContext returns a Context for unit testing the states, with the services(can be fakes) to look up
+func NewTestContext(ctx xcapi.Context, services *ServiceContainer) Context {
+	return newContext(context.Background(), ctx, "", "", DefaultNamespace, services)
 }
 
 type contextImpl struct {
-	ctx xcapi.Context
+	context.Context
+	ctx       xcapi.Context
+	prcType   string
+	stateId   string
+	namespace string
+	services  *ServiceContainer
 }
 
 func (c contextImpl) GetProcessId() string {
 	return c.ctx.GetProcessId()
 }
 
+func (c contextImpl) GetProcessExecutionId() string {
+	return c.ctx.GetProcessExecutionId()
+}
+
+func (c contextImpl) GetProcessType() string {
+	return c.prcType
+}
+
+func (c contextImpl) GetNamespace() string {
+	return c.namespace
+}
+
+func (c contextImpl) GetStateId() string {
+	return c.stateId
+}
+
+func (c contextImpl) GetStateExecutionId() string {
+	return c.ctx.GetStateExecutionId()
+}
+
+func (c contextImpl) GetProcessStartTime() time.Time {
+	return time.Unix(c.ctx.GetProcessStartedTimestamp(), 0)
+}
+
+func (c contextImpl) GetFirstAttemptStartTime() time.Time {
+	if c.ctx.FirstAttemptTimestamp == nil {
+		return time.Time{}
+	}
+	return time.Unix(c.ctx.GetFirstAttemptTimestamp(), 0)
+}
+
 func (c contextImpl) GetAttempt() int {
 	return int(c.ctx.GetAttempt())
 }
@@ -32,3 +105,7 @@ func (c contextImpl) GetRecoverFromStateExecutionId() *string {
 func (c contextImpl) GetRecoverFromStateApi() *xcapi.WorkerApiType {
 	return c.ctx.RecoverFromApi
 }
+
+func (c contextImpl) GetServices() *ServiceContainer {
+	return c.services
+}
@@ -1,9 +1,23 @@
 package xc
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"reflect"
 	"runtime/debug"
+	"time"
+)
+
+// The ErrorTypes below need the support of xCherry server, which doesn't handle them yet.
+// Until then, the state APIs failed with them are retried by the retry policy of the state as any other errors
+const (
+	// WorkerErrorTypeNonRetryable is the WorkerErrorResponse.ErrorType of NonRetryableError
+	WorkerErrorTypeNonRetryable = "xc.NonRetryableError"
+	// WorkerErrorTypeRetryable is the WorkerErrorResponse.ErrorType of RetryableError,
+	// with the WorkerErrorResponse.RetryDelaySeconds
+	WorkerErrorTypeRetryable = "xc.RetryableError"
 )
 
 // WorkerExecutionError represents runtime errors on worker execution
@@ -23,6 +37,180 @@ func (i WorkerExecutionError) Error() string {
 	return fmt.Sprintf("error message:%v, stacktrace: %v", i.OriginalError, i.StackTrace)
 }
 
+func (i WorkerExecutionError) Unwrap() error {
+	return i.OriginalError
+}
+
+// NonRetryableError represents an error that should not be retried, e.g. a payload that can never be processed.
+// Return it from WaitUntil/Execute so that the server skips the retry policy, and fails the state execution
+// immediately(or proceeds to the FailureRecoveryState if configured)
+type NonRetryableError struct {
+	OriginalError error
+}
+
+// NewNonRetryableError wraps the error as NonRetryableError
+func NewNonRetryableError(err error) error {
+	return &NonRetryableError{
+		OriginalError: err,
+	}
+}
+
+func (e NonRetryableError) Error() string {
+	return fmt.Sprintf("non-retryable error: %v", e.OriginalError)
+}
+
+func (e NonRetryableError) Unwrap() error {
+	return e.OriginalError
+}
+
+// RetryableError represents an error that should be retried after the RetryDelay,
+// instead of the next interval of the retry policy
+type RetryableError struct {
+	OriginalError error
+	RetryDelay    time.Duration
+}
+
+// NewRetryableErrorWithDelay wraps the error as RetryableError with the hint of retry delay
+func NewRetryableErrorWithDelay(err error, retryDelay time.Duration) error {
+	return &RetryableError{
+		OriginalError: err,
+		RetryDelay:    retryDelay,
+	}
+}
+
+func (e RetryableError) Error() string {
+	return fmt.Sprintf("retryable error with delay %v: %v", e.RetryDelay, e.OriginalError)
+}
+
+func (e RetryableError) Unwrap() error {
+	return e.OriginalError
+}
+
+// WorkerOverloadedError is returned by WorkerService when the request is rejected by WorkerConcurrencyOptions
+// It should be returned as HTTP 429 with the Retry-After header, see GetRetryAfterSeconds
+type WorkerOverloadedError struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func NewWorkerOverloadedError(message string, retryAfter time.Duration) error {
+	return &WorkerOverloadedError{
+		Message:    message,
+		RetryAfter: retryAfter,
+	}
+}
+
+func (e WorkerOverloadedError) Error() string {
+	return fmt.Sprintf("worker is overloaded: %v, retry after %v", e.Message, e.RetryAfter)
+}
+
+// ExternalTaskFailedError is returned by ExternalTaskOutcome.Err when the task is failed by Client.FailExternalTask
+type ExternalTaskFailedError struct {
+	Reason string
+}
+
+func (e ExternalTaskFailedError) Error() string {
+	return fmt.Sprintf("external task is failed: %v", e.Reason)
+}
+
+// ExternalTaskTimeoutError is returned by ExternalTaskOutcome.Err when the task is timed out,
+// HeartbeatTimeout is true if it's the heartbeat timeout
+type ExternalTaskTimeoutError struct {
+	HeartbeatTimeout bool
+}
+
+func (e ExternalTaskTimeoutError) Error() string {
+	if e.HeartbeatTimeout {
+		return "external task is timed out waiting for heartbeat"
+	}
+	return "external task is timed out"
+}
+
+// IsExternalTaskFailedError returns true if the error is or wraps an ExternalTaskFailedError
+func IsExternalTaskFailedError(err error) bool {
+	var fErr *ExternalTaskFailedError
+	return errors.As(err, &fErr)
+}
+
+// IsExternalTaskTimeoutError returns true if the error is or wraps an ExternalTaskTimeoutError
+func IsExternalTaskTimeoutError(err error) bool {
+	var tErr *ExternalTaskTimeoutError
+	return errors.As(err, &tErr)
+}
+
+// IsWorkerOverloadedError returns true if the error is or wraps a WorkerOverloadedError
+func IsWorkerOverloadedError(err error) bool {
+	var oErr *WorkerOverloadedError
+	return errors.As(err, &oErr)
+}
+
+// IsNonRetryableError returns true if the error is or wraps a NonRetryableError
+func IsNonRetryableError(err error) bool {
+	var nrErr *NonRetryableError
+	return errors.As(err, &nrErr)
+}
+
+// GetRetryDelayHint returns the retry delay hint if the error is or wraps a RetryableError or WorkerOverloadedError
+func GetRetryDelayHint(err error) (time.Duration, bool) {
+	var rErr *RetryableError
+	if errors.As(err, &rErr) {
+		return rErr.RetryDelay, true
+	}
+	var oErr *WorkerOverloadedError
+	if errors.As(err, &oErr) {
+		return oErr.RetryAfter, true
+	}
+	return 0, false
+}
+
+// GetRetryAfterSeconds returns the retry delay hint rounded up to seconds, e.g. for the Retry-After HTTP header
+func GetRetryAfterSeconds(err error) (int, bool) {
+	delay, ok := GetRetryDelayHint(err)
+	if !ok {
+		return 0, false
+	}
+	return int(math.Ceil(delay.Seconds())), true
+}
+
+// WorkerErrorResponse is the xcapi.WorkerErrorResponse with the retry delay hint,
+// which is serialized into the same JSON with the extra retryDelaySeconds field
+type WorkerErrorResponse struct {
+	Detail    *string `json:"detail,omitempty"`
+	ErrorType string  `json:"errorType"`
+	// RetryDelaySeconds is the retry delay hint of RetryableError or WorkerOverloadedError, rounded up to seconds.
+	// It needs the support of xCherry server like WorkerErrorTypeRetryable
+	RetryDelaySeconds *int `json:"retryDelaySeconds,omitempty"`
+}
+
+// NewWorkerErrorResponse converts the error returned from WorkerService into the WorkerErrorResponse,
+// which should be returned with http.StatusFailedDependency(424) to the server
+func NewWorkerErrorResponse(err error) WorkerErrorResponse {
+	detail := err.Error()
+	resp := WorkerErrorResponse{
+		Detail:    &detail,
+		ErrorType: getWorkerErrorType(err),
+	}
+	if retryAfter, ok := GetRetryAfterSeconds(err); ok {
+		resp.RetryDelaySeconds = &retryAfter
+	}
+	return resp
+}
+
+func getWorkerErrorType(err error) string {
+	if IsNonRetryableError(err) {
+		return WorkerErrorTypeNonRetryable
+	}
+	if _, ok := GetRetryDelayHint(err); ok {
+		return WorkerErrorTypeRetryable
+	}
+	original := err
+	var wErr *WorkerExecutionError
+	if errors.As(err, &wErr) && wErr.OriginalError != nil {
+		original = wErr.OriginalError
+	}
+	return reflect.TypeOf(original).String()
+}
+
 // for skipping the logging in testing code
 var skipCaptureErrorLogging = false
 
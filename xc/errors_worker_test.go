@@ -0,0 +1,46 @@
+package xc
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+func TestWorkerErrorResponse(t *testing.T) {
+	skipCaptureErrorLogging = true
+	defer func() { skipCaptureErrorLogging = false }()
+
+	capture := func(retErr error) error {
+		captureStateExecutionError(nil, &retErr)
+		return retErr
+	}
+
+	err := capture(NewNonRetryableError(fmt.Errorf("invalid payload")))
+	assert.True(t, IsNonRetryableError(err))
+	assert.Equal(t, WorkerErrorTypeNonRetryable, NewWorkerErrorResponse(err).ErrorType)
+
+	err = capture(NewRetryableErrorWithDelay(fmt.Errorf("rate limited"), time.Millisecond*1500))
+	assert.False(t, IsNonRetryableError(err))
+	retryAfter, ok := GetRetryAfterSeconds(err)
+	assert.True(t, ok)
+	assert.Equal(t, 2, retryAfter)
+	resp := NewWorkerErrorResponse(err)
+	assert.Equal(t, WorkerErrorTypeRetryable, resp.ErrorType)
+	assert.Equal(t, 2, *resp.RetryDelaySeconds)
+	bs, jsonErr := json.Marshal(resp)
+	assert.Nil(t, jsonErr)
+	assert.Contains(t, string(bs), `"retryDelaySeconds":2`)
+	var apiResp xcapi.WorkerErrorResponse
+	assert.Nil(t, json.Unmarshal(bs, &apiResp))
+	assert.Equal(t, WorkerErrorTypeRetryable, apiResp.ErrorType)
+
+	err = capture(NewProcessDefinitionError("test"))
+	_, ok = GetRetryDelayHint(err)
+	assert.False(t, ok)
+	assert.Equal(t, "*xc.ProcessDefinitionError", NewWorkerErrorResponse(err).ErrorType)
+	assert.Nil(t, NewWorkerErrorResponse(err).RetryDelaySeconds)
+}
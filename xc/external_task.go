@@ -0,0 +1,324 @@
+package xc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+// ExternalTaskQueuePrefix is the prefix of the local queues of the ExternalTasks
+const ExternalTaskQueuePrefix = "xc.externalTask."
+
+// externalTaskDeadlineTolerance is the tolerance to tell a fired timer is for the task deadline
+// rather than the heartbeat timeout, because the timers are in seconds
+const externalTaskDeadlineTolerance = time.Second
+
+// ExternalTask is a typed task that is completed by an external system, which can take much longer than
+// the timeout of WaitUntil/Execute API, e.g. a ML inference job. It's declared once, e.g.
+//
+//	var inferenceTask = xc.NewExternalTask[InferenceResult]("inference").
+//		WithTimeout(time.Hour).
+//		WithHeartbeatTimeout(5 * time.Minute)
+//
+// The local queues of the task must be declared in the CommunicationSchema of the process:
+//
+//	xc.NewCommunicationSchema(inferenceTask.GetLocalQueueDefs()...)
+//
+// A state issues the token by NewToken, hands token.String() to the external system, and moves to
+// the waiting state with the token as input. The WaitUntil of the waiting state returns WaitForCompletion,
+// and the Execute gets the outcome by GetOutcome, moving to itself with ExternalTaskOutcome.Token while the task is running.
+// The external system calls Client.CompleteExternalTask or Client.FailExternalTask with the token string,
+// and optionally Client.HeartbeatExternalTask to report the progress.
+type ExternalTask[T any] struct {
+	name             string
+	timeout          time.Duration
+	heartbeatTimeout time.Duration
+}
+
+// ExternalTaskToken identifies an issued ExternalTask, it's the input of the waiting state.
+// Use String to hand it to the external system
+type ExternalTaskToken struct {
+	Namespace string `json:"namespace"`
+	ProcessId string `json:"processId"`
+	TaskName  string `json:"taskName"`
+	// TaskId is unique to the state execution that issued the token, so that the messages of
+	// the earlier tasks of the same name are ignored
+	TaskId string `json:"taskId"`
+	// Deadline is the unix seconds of the task timeout, 0 means no timeout
+	Deadline int64 `json:"deadline,omitempty"`
+	// HeartbeatAt is the unix seconds of the newest heartbeat, or when the token is issued if there is none yet.
+	// The heartbeat timeout is measured from it
+	HeartbeatAt int64 `json:"heartbeatAt,omitempty"`
+}
+
+// ExternalTaskCompletion is the message of the completion queue of an ExternalTask
+type ExternalTaskCompletion struct {
+	TaskId        string               `json:"taskId"`
+	Result        *xcapi.EncodedObject `json:"result,omitempty"`
+	FailureReason *string              `json:"failureReason,omitempty"`
+}
+
+// ExternalTaskHeartbeat is the message of the heartbeat queue of an ExternalTask
+type ExternalTaskHeartbeat struct {
+	TaskId string `json:"taskId"`
+	// SentAt is the unix seconds when the heartbeat is sent
+	SentAt  int64                `json:"sentAt,omitempty"`
+	Details *xcapi.EncodedObject `json:"details,omitempty"`
+}
+
+type ExternalTaskStatus string
+
+const (
+	ExternalTaskCompleted ExternalTaskStatus = "COMPLETED"
+	ExternalTaskFailed    ExternalTaskStatus = "FAILED"
+	// ExternalTaskRunning means a heartbeat(or a stale message of an earlier task) is received,
+	// the state should wait for the completion again
+	ExternalTaskRunning          ExternalTaskStatus = "RUNNING"
+	ExternalTaskTimedOut         ExternalTaskStatus = "TIMED_OUT"
+	ExternalTaskHeartbeatTimeout ExternalTaskStatus = "HEARTBEAT_TIMED_OUT"
+)
+
+// ExternalTaskOutcome is the outcome of waiting for an ExternalTask
+type ExternalTaskOutcome[T any] struct {
+	Status ExternalTaskStatus
+	// Result is the result of ExternalTaskCompleted
+	Result T
+	// FailureReason is the reason of ExternalTaskFailed
+	FailureReason string
+	// HeartbeatDetails is the details of the newest heartbeat of ExternalTaskRunning, nil if there is none
+	HeartbeatDetails Object
+	// Token is the token to wait for the completion again with, which has the time of the newest heartbeat
+	Token ExternalTaskToken
+}
+
+// NewExternalTask returns an ExternalTask of the result type T, without timeout and heartbeat timeout
+func NewExternalTask[T any](name string) ExternalTask[T] {
+	return ExternalTask[T]{
+		name: name,
+	}
+}
+
+// WithTimeout returns a copy of the ExternalTask with the timeout since the token is issued
+func (t ExternalTask[T]) WithTimeout(timeout time.Duration) ExternalTask[T] {
+	t.timeout = timeout
+	return t
+}
+
+// WithHeartbeatTimeout returns a copy of the ExternalTask with the heartbeat timeout,
+// which is the maximum duration to wait for the next heartbeat(or the completion)
+func (t ExternalTask[T]) WithHeartbeatTimeout(heartbeatTimeout time.Duration) ExternalTask[T] {
+	t.heartbeatTimeout = heartbeatTimeout
+	return t
+}
+
+func (t ExternalTask[T]) GetName() string {
+	return t.name
+}
+
+// GetCompletionQueueName returns the name of the local queue of the ExternalTaskCompletion messages
+func (t ExternalTask[T]) GetCompletionQueueName() string {
+	return getExternalTaskCompletionQueueName(t.name)
+}
+
+// GetHeartbeatQueueName returns the name of the local queue of the ExternalTaskHeartbeat messages
+func (t ExternalTask[T]) GetHeartbeatQueueName() string {
+	return getExternalTaskHeartbeatQueueName(t.name)
+}
+
+// GetLocalQueueDefs returns the local queues of the task to declare in the CommunicationSchema
+func (t ExternalTask[T]) GetLocalQueueDefs() []LocalQueueDef {
+	return []LocalQueueDef{
+		NewLocalQueueDef(t.GetCompletionQueueName(), ExternalTaskCompletion{}),
+		NewLocalQueueDef(t.GetHeartbeatQueueName(), ExternalTaskHeartbeat{}),
+	}
+}
+
+// NewToken issues the token of the task for the current state execution, panics on error.
+// The token is the same across the retries of the state API
+func (t ExternalTask[T]) NewToken(ctx Context) ExternalTaskToken {
+	token, err := t.NewTokenE(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return token
+}
+
+// NewTokenE is the same as NewToken, but returns error instead of panic.
+// The timeouts are measured from the first attempt of the state API,
+// or from now if the Context has no first attempt start time, e.g. on the first attempt
+func (t ExternalTask[T]) NewTokenE(ctx Context) (ExternalTaskToken, error) {
+	token := ExternalTaskToken{
+		Namespace: ctx.GetNamespace(),
+		ProcessId: ctx.GetProcessId(),
+		TaskName:  t.name,
+		TaskId:    ctx.GetProcessExecutionId() + "/" + ctx.GetStateExecutionId(),
+	}
+	if t.timeout == 0 && t.heartbeatTimeout == 0 {
+		return token, nil
+	}
+	issuedAt := ctx.GetFirstAttemptStartTime()
+	if issuedAt.IsZero() {
+		issuedAt = time.Now()
+	}
+	if t.timeout > 0 {
+		token.Deadline = issuedAt.Add(t.timeout).Unix()
+	}
+	if t.heartbeatTimeout > 0 {
+		token.HeartbeatAt = issuedAt.Unix()
+	}
+	return token, nil
+}
+
+// WaitForCompletion returns the CommandRequest to wait for the completion or a heartbeat of the task,
+// or the timer of the timeout/heartbeat timeout, whichever is earlier.
+// One heartbeat is received at a time, so the pending heartbeats are drained one per round of the waiting state,
+// which doesn't wait as they are already in the queue. The heartbeat timeout is measured from the newest one
+func (t ExternalTask[T]) WaitForCompletion(token ExternalTaskToken) *CommandRequest {
+	commands := []Command{
+		NewLocalQueueCommand(t.GetCompletionQueueName(), 1),
+		NewLocalQueueCommand(t.GetHeartbeatQueueName(), 1),
+	}
+	now := time.Now()
+	hasTimer := false
+	var timeout time.Duration
+	if t.heartbeatTimeout > 0 {
+		timeout = t.heartbeatTimeout
+		if token.HeartbeatAt > 0 {
+			timeout = time.Unix(token.HeartbeatAt, 0).Add(t.heartbeatTimeout).Sub(now)
+		}
+		hasTimer = true
+	}
+	if token.Deadline > 0 {
+		remaining := time.Unix(token.Deadline, 0).Sub(now)
+		if !hasTimer || remaining < timeout {
+			timeout = remaining
+		}
+		hasTimer = true
+	}
+	if hasTimer {
+		if timeout < 0 {
+			timeout = 0
+		}
+		// round up to seconds so that the timer doesn't fire before the deadline
+		timeout = (timeout + time.Second - 1).Truncate(time.Second)
+		commands = append(commands, NewTimerCommand(timeout))
+	}
+	return AnyOf(commands...)
+}
+
+// GetOutcome returns the outcome of the task from the CommandResults of WaitForCompletion
+func (t ExternalTask[T]) GetOutcome(token ExternalTaskToken, commandResults CommandResults) (ExternalTaskOutcome[T], error) {
+	outcome := ExternalTaskOutcome[T]{
+		Token: token,
+	}
+	if r, ok := commandResults.getLocalQueueResultByQueueName(t.GetCompletionQueueName()); ok &&
+		r.GetStatus() == xcapi.COMPLETED_COMMAND {
+		var completion ExternalTaskCompletion
+		if err := r.GetFirstMessageE(&completion); err != nil {
+			return outcome, err
+		}
+		switch {
+		case completion.TaskId != token.TaskId:
+			outcome.Status = ExternalTaskRunning
+		case completion.FailureReason != nil:
+			outcome.Status = ExternalTaskFailed
+			outcome.FailureReason = *completion.FailureReason
+		default:
+			outcome.Status = ExternalTaskCompleted
+			if completion.Result != nil {
+				if err := r.Encoder.Decode(completion.Result, &outcome.Result); err != nil {
+					return outcome, err
+				}
+			}
+		}
+		return outcome, nil
+	}
+
+	if r, ok := commandResults.getLocalQueueResultByQueueName(t.GetHeartbeatQueueName()); ok &&
+		r.GetStatus() == xcapi.COMPLETED_COMMAND {
+		outcome.Status = ExternalTaskRunning
+		var newest *ExternalTaskHeartbeat
+		for _, msg := range r.GetMessages() {
+			var heartbeat ExternalTaskHeartbeat
+			if err := msg.GetE(&heartbeat); err != nil {
+				return outcome, err
+			}
+			// the heartbeats of the earlier tasks don't count
+			if heartbeat.TaskId == token.TaskId && (newest == nil || heartbeat.SentAt >= newest.SentAt) {
+				newest = &heartbeat
+			}
+		}
+		if newest != nil {
+			if newest.SentAt > outcome.Token.HeartbeatAt {
+				outcome.Token.HeartbeatAt = newest.SentAt
+			}
+			if newest.Details != nil {
+				outcome.HeartbeatDetails = NewObject(newest.Details, r.Encoder)
+			}
+		}
+		return outcome, nil
+	}
+
+	if t.heartbeatTimeout == 0 ||
+		(token.Deadline > 0 && !time.Now().Add(externalTaskDeadlineTolerance).Before(time.Unix(token.Deadline, 0))) {
+		outcome.Status = ExternalTaskTimedOut
+	} else {
+		outcome.Status = ExternalTaskHeartbeatTimeout
+	}
+	return outcome, nil
+}
+
+// IsRunning returns true if the state should wait for the completion of the task again
+func (o ExternalTaskOutcome[T]) IsRunning() bool {
+	return o.Status == ExternalTaskRunning
+}
+
+// Err returns ExternalTaskFailedError or ExternalTaskTimeoutError if the task is failed or timed out
+func (o ExternalTaskOutcome[T]) Err() error {
+	switch o.Status {
+	case ExternalTaskFailed:
+		return &ExternalTaskFailedError{
+			Reason: o.FailureReason,
+		}
+	case ExternalTaskTimedOut, ExternalTaskHeartbeatTimeout:
+		return &ExternalTaskTimeoutError{
+			HeartbeatTimeout: o.Status == ExternalTaskHeartbeatTimeout,
+		}
+	}
+	return nil
+}
+
+// String returns the opaque string of the token to hand to the external system
+func (t ExternalTaskToken) String() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseExternalTaskToken parses the string returned by ExternalTaskToken.String
+func ParseExternalTaskToken(token string) (ExternalTaskToken, error) {
+	var ret ExternalTaskToken
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ret, NewInvalidArgumentError("invalid external task token: %v", err)
+	}
+	if err := json.Unmarshal(data, &ret); err != nil {
+		return ret, NewInvalidArgumentError("invalid external task token: %v", err)
+	}
+	if ret.ProcessId == "" || ret.TaskName == "" || ret.TaskId == "" {
+		return ret, NewInvalidArgumentError("invalid external task token: missing processId, taskName or taskId")
+	}
+	return ret, nil
+}
+
+func getExternalTaskCompletionQueueName(taskName string) string {
+	return ExternalTaskQueuePrefix + taskName
+}
+
+func getExternalTaskHeartbeatQueueName(taskName string) string {
+	return ExternalTaskQueuePrefix + taskName + ".heartbeat"
+}
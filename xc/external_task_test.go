@@ -0,0 +1,257 @@
+package xc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+type inferenceResult struct {
+	Label string
+}
+
+var testInferenceTask = NewExternalTask[inferenceResult]("inference").
+	WithTimeout(time.Hour).
+	WithHeartbeatTimeout(5 * time.Minute)
+
+type externalTaskTestProcess struct {
+	ProcessDefaults
+}
+
+func (p externalTaskTestProcess) GetAsyncStateSchema() StateSchema {
+	return NewStateSchema(&statefulTestState{})
+}
+
+func (p externalTaskTestProcess) GetCommunicationSchema() CommunicationSchema {
+	return NewCommunicationSchema(testInferenceTask.GetLocalQueueDefs()...)
+}
+
+type publishTestTransport struct {
+	ClientTransport
+	processType string
+	requests    []xcapi.PublishToLocalQueueRequest
+}
+
+func (t *publishTestTransport) DescribeProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionDescribeRequest,
+) (*xcapi.ProcessExecutionDescribeResponse, error) {
+	return &xcapi.ProcessExecutionDescribeResponse{
+		ProcessExecutionId: xcapi.PtrString("execution-" + request.ProcessId),
+		ProcessType:        xcapi.PtrString(t.processType),
+		Status:             xcapi.RUNNING.Ptr(),
+	}, nil
+}
+
+func (t *publishTestTransport) PublishToLocalQueue(ctx context.Context, request xcapi.PublishToLocalQueueRequest) error {
+	t.requests = append(t.requests, request)
+	return nil
+}
+
+// toCommandResults returns the CommandResults of WaitForCompletion consuming the published message
+func (t *publishTestTransport) toCommandResults(index int) CommandResults {
+	msg := t.requests[index].Messages[0]
+	var results CommandResults
+	for _, queueName := range []string{
+		testInferenceTask.GetCompletionQueueName(), testInferenceTask.GetHeartbeatQueueName(),
+	} {
+		result := xcapi.LocalQueueResult{
+			QueueName: queueName,
+			Status:    xcapi.WAITING_COMMAND,
+		}
+		if queueName == msg.QueueName {
+			result.Status = xcapi.COMPLETED_COMMAND
+			result.Messages = []xcapi.LocalQueueMessageResult{{Payload: msg.Payload}}
+		}
+		results.LocalQueueResults = append(results.LocalQueueResults, LocalQueueCommandResult{
+			CommandId: queueName,
+			Result:    result,
+			Encoder:   GetDefaultObjectEncoder(),
+		})
+	}
+	return results
+}
+
+func newTestExternalTaskToken() ExternalTaskToken {
+	ctx := NewTestContext(xcapi.Context{
+		ProcessId:             "process-1",
+		ProcessExecutionId:    "execution-1",
+		StateExecutionId:      xcapi.PtrString("state1-1"),
+		FirstAttemptTimestamp: xcapi.PtrInt64(time.Now().Unix()),
+	}, nil)
+	return testInferenceTask.NewToken(ctx)
+}
+
+func TestExternalTaskToken(t *testing.T) {
+	token := newTestExternalTaskToken()
+	assert.Equal(t, "execution-1/state1-1", token.TaskId)
+	assert.Equal(t, DefaultNamespace, token.Namespace)
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), token.Deadline, 1)
+	assert.InDelta(t, time.Now().Unix(), token.HeartbeatAt, 1)
+
+	parsed, err := ParseExternalTaskToken(token.String())
+	assert.Nil(t, err)
+	assert.Equal(t, token, parsed)
+
+	_, err = ParseExternalTaskToken("not a token")
+	assert.Error(t, err)
+	_, err = ParseExternalTaskToken(ExternalTaskToken{ProcessId: "process-1"}.String())
+	assert.Error(t, err)
+
+	// the timeouts are measured from now without the first attempt start time
+	ctx := NewTestContext(xcapi.Context{ProcessId: "process-1", ProcessExecutionId: "execution-1"}, nil)
+	token, err = testInferenceTask.NewTokenE(ctx)
+	assert.Nil(t, err)
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), token.Deadline, 1)
+	assert.InDelta(t, time.Now().Unix(), token.HeartbeatAt, 1)
+	assert.NotPanics(t, func() { testInferenceTask.NewToken(ctx) })
+	token, err = NewExternalTask[inferenceResult]("no-timeout").NewTokenE(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), token.Deadline)
+}
+
+func TestExternalTaskWaitForCompletion(t *testing.T) {
+	token := newTestExternalTaskToken()
+	commands, waitingType, ok := testInferenceTask.WaitForCompletion(token).flatten()
+	assert.True(t, ok)
+	assert.Equal(t, xcapi.ANY_OF_COMPLETION, waitingType)
+	assert.Equal(t, 3, len(commands))
+	assert.Equal(t, 1, commands[1].LocalQueueCommand.Count)
+	assert.InDelta(t, 300, commands[2].TimerCommand.DelayInSeconds, 1)
+
+	// the heartbeat timeout is measured from the newest heartbeat
+	token.HeartbeatAt = time.Now().Add(-4 * time.Minute).Unix()
+	commands, _, ok = testInferenceTask.WaitForCompletion(token).flatten()
+	assert.True(t, ok)
+	assert.InDelta(t, 60, commands[2].TimerCommand.DelayInSeconds, 1)
+	token.HeartbeatAt = time.Now().Unix()
+
+	token.Deadline = time.Now().Add(time.Minute).Unix()
+	commands, _, ok = testInferenceTask.WaitForCompletion(token).flatten()
+	assert.True(t, ok)
+	assert.InDelta(t, 60, commands[2].TimerCommand.DelayInSeconds, 1)
+
+	token.Deadline = time.Now().Add(-time.Minute).Unix()
+	commands, _, ok = testInferenceTask.WaitForCompletion(token).flatten()
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), commands[2].TimerCommand.DelayInSeconds)
+
+	token.Deadline = 0
+	commands, _, ok = NewExternalTask[inferenceResult]("no-timeout").WaitForCompletion(token).flatten()
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(commands))
+}
+
+func TestExternalTaskCompletion(t *testing.T) {
+	registry := NewRegistry()
+	assert.Nil(t, registry.AddProcess(externalTaskTestProcess{}))
+	transport := &publishTestTransport{
+		processType: GetFinalProcessType(externalTaskTestProcess{}),
+	}
+	options := *GetLocalDefaultClientOptions()
+	options.Transport = transport
+	client := NewClient(registry, &options)
+	ctx := context.Background()
+	token := newTestExternalTaskToken()
+
+	assert.Nil(t, client.HeartbeatExternalTask(ctx, token.String(), "50%"))
+	assert.Nil(t, client.CompleteExternalTask(ctx, token.String(), inferenceResult{Label: "cat"}))
+	assert.Nil(t, client.FailExternalTask(ctx, token.String(), "out of memory"))
+	assert.Equal(t, 3, len(transport.requests))
+	assert.Equal(t, "process-1", transport.requests[0].ProcessId)
+	assert.Nil(t, transport.requests[0].Messages[0].DedupId)
+	assert.Equal(t, transport.requests[1].Messages[0].DedupId, transport.requests[2].Messages[0].DedupId)
+
+	outcome, err := testInferenceTask.GetOutcome(token, transport.toCommandResults(0))
+	assert.Nil(t, err)
+	assert.True(t, outcome.IsRunning())
+	var progress string
+	outcome.HeartbeatDetails.Get(&progress)
+	assert.Equal(t, "50%", progress)
+	assert.Nil(t, outcome.Err())
+
+	outcome, err = testInferenceTask.GetOutcome(token, transport.toCommandResults(1))
+	assert.Nil(t, err)
+	assert.Equal(t, ExternalTaskCompleted, outcome.Status)
+	assert.Equal(t, "cat", outcome.Result.Label)
+
+	outcome, err = testInferenceTask.GetOutcome(token, transport.toCommandResults(2))
+	assert.Nil(t, err)
+	assert.Equal(t, ExternalTaskFailed, outcome.Status)
+	assert.True(t, IsExternalTaskFailedError(outcome.Err()))
+
+	// the messages of an earlier task are ignored
+	staleToken := token
+	staleToken.TaskId = "execution-1/state1-2"
+	outcome, err = testInferenceTask.GetOutcome(staleToken, transport.toCommandResults(1))
+	assert.Nil(t, err)
+	assert.True(t, outcome.IsRunning())
+	assert.Nil(t, outcome.HeartbeatDetails)
+
+	// the queues of the task are not declared by any registered process
+	assert.Error(t, client.CompleteExternalTask(ctx, ExternalTaskToken{
+		ProcessId: "process-1", TaskName: "unknown", TaskId: token.TaskId,
+	}.String(), 1))
+	assert.Error(t, client.CompleteExternalTask(ctx, "not a token", 1))
+}
+
+func TestExternalTaskHeartbeats(t *testing.T) {
+	token := newTestExternalTaskToken()
+	newHeartbeat := func(taskId string, sentAt int64, details string) xcapi.LocalQueueMessageResult {
+		encodedDetails, err := GetDefaultObjectEncoder().Encode(details)
+		assert.Nil(t, err)
+		payload, err := GetDefaultObjectEncoder().Encode(ExternalTaskHeartbeat{
+			TaskId: taskId, SentAt: sentAt, Details: encodedDetails,
+		})
+		assert.Nil(t, err)
+		return xcapi.LocalQueueMessageResult{Payload: payload}
+	}
+	results := CommandResults{
+		LocalQueueResults: []LocalQueueCommandResult{{
+			Result: xcapi.LocalQueueResult{
+				QueueName: testInferenceTask.GetHeartbeatQueueName(),
+				Status:    xcapi.COMPLETED_COMMAND,
+				Messages: []xcapi.LocalQueueMessageResult{
+					newHeartbeat(token.TaskId, token.HeartbeatAt+20, "20%"),
+					newHeartbeat(token.TaskId, token.HeartbeatAt+30, "30%"),
+					newHeartbeat("execution-1/state1-0", token.HeartbeatAt+40, "stale"),
+					newHeartbeat(token.TaskId, token.HeartbeatAt+10, "10%"),
+				},
+			},
+			Encoder: GetDefaultObjectEncoder(),
+		}},
+	}
+
+	outcome, err := testInferenceTask.GetOutcome(token, results)
+	assert.Nil(t, err)
+	assert.True(t, outcome.IsRunning())
+	var progress string
+	outcome.HeartbeatDetails.Get(&progress)
+	assert.Equal(t, "30%", progress)
+	assert.Equal(t, token.HeartbeatAt+30, outcome.Token.HeartbeatAt)
+	assert.Equal(t, token.TaskId, outcome.Token.TaskId)
+
+	// the heartbeats older than the token don't move it back
+	outcome, err = testInferenceTask.GetOutcome(outcome.Token, results)
+	assert.Nil(t, err)
+	assert.Equal(t, token.HeartbeatAt+30, outcome.Token.HeartbeatAt)
+}
+
+func TestExternalTaskTimeout(t *testing.T) {
+	token := newTestExternalTaskToken()
+	timerFired := CommandResults{
+		TimerResults: []TimerResult{{Status: xcapi.COMPLETED_COMMAND}},
+	}
+	outcome, err := testInferenceTask.GetOutcome(token, timerFired)
+	assert.Nil(t, err)
+	assert.Equal(t, ExternalTaskHeartbeatTimeout, outcome.Status)
+	assert.True(t, IsExternalTaskTimeoutError(outcome.Err()))
+
+	token.Deadline = time.Now().Unix()
+	outcome, err = testInferenceTask.GetOutcome(token, timerFired)
+	assert.Nil(t, err)
+	assert.Equal(t, ExternalTaskTimedOut, outcome.Status)
+	assert.True(t, IsExternalTaskTimeoutError(outcome.Err()))
+}
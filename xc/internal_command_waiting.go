@@ -0,0 +1,256 @@
+package xc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+// internalCommandRequestQueuePrefix is the prefix of the local queue of the SDK to pass the CommandRequest
+// from WaitUntil to Execute, because the server doesn't return the commandIds or the combination of the commands
+// in the command results. The queue is per state execution, so that the concurrent state executions of a process
+// don't consume the CommandRequests of each other
+const internalCommandRequestQueuePrefix = "xc.internal.commandRequest."
+
+// encodingTypeCommandWaiting is the encoding of the commandWaiting, as the message of the internal command request
+// queue and the input of the state when waiting again
+const encodingTypeCommandWaiting = "xcherryCommandWaiting"
+
+// commandWaiting is the CommandRequest that is waited by the SDK, instead of the server.
+// The WaitUntil publishes it to the internal command request queue of the state execution and waits for any of the commands,
+// then the Execute merges the results, and moves to the same state with the commandWaiting as input
+// to wait for the rest of the commands, until the CommandRequest is satisfied.
+type commandWaiting struct {
+	// Input is the original input of the state
+	Input   *xcapi.EncodedObject `json:"input,omitempty"`
+	Request *CommandRequest      `json:"request"`
+	// StartedAt is the unix milliseconds when the WaitUntil returns the CommandRequest, for the timers
+	StartedAt int64 `json:"startedAt"`
+	// Results are the results of Request.getCommands() so far
+	Results []commandWaitingResult `json:"results,omitempty"`
+}
+
+type commandWaitingResult struct {
+	Status   xcapi.CommandStatus             `json:"status"`
+	Messages []xcapi.LocalQueueMessageResult `json:"messages,omitempty"`
+}
+
+// needsCommandWaiting returns true if the CommandRequest must be waited by the SDK
+func needsCommandWaiting(request *CommandRequest) bool {
+	if request == nil {
+		return false
+	}
+	_, _, ok := request.flatten()
+	return !ok || request.hasCommandIds()
+}
+
+// getInternalCommandRequestQueueName returns the internal command request queue of the state execution
+func getInternalCommandRequestQueueName(stateExecutionId string) string {
+	return internalCommandRequestQueuePrefix + stateExecutionId
+}
+
+// startCommandWaiting returns the request to the server for the first round of waiting, and the message of
+// the internal command request queue to publish, which completes the round immediately
+// so that the Execute gets the request
+func startCommandWaiting(
+	request *CommandRequest, commSchema CommunicationSchema, stateExecutionId string, now time.Time,
+) (*xcapi.CommandRequest, *xcapi.LocalQueueMessage, error) {
+	if err := request.validate(); err != nil {
+		return nil, nil, err
+	}
+	apiRequest, err := toApiCommandRequest(&CommandRequest{
+		Commands:           request.getCommands(),
+		CommandWaitingType: xcapi.ANY_OF_COMPLETION,
+	}, commSchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	apiRequest.WaitingType = xcapi.ANY_OF_COMPLETION
+	queueName := getInternalCommandRequestQueueName(stateExecutionId)
+	apiRequest.LocalQueueCommands = append(apiRequest.LocalQueueCommands, *xcapi.NewLocalQueueCommand(queueName))
+
+	payload, err := encodeCommandWaiting(&commandWaiting{
+		Request:   request,
+		StartedAt: now.UnixMilli(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return apiRequest, &xcapi.LocalQueueMessage{
+		QueueName: queueName,
+		Payload:   payload,
+	}, nil
+}
+
+// getCommandWaiting returns the commandWaiting with the results merged from the Execute request,
+// or false if the state is not waiting by the SDK
+func getCommandWaiting(
+	stateInput *xcapi.EncodedObject, results *xcapi.CommandResults, stateExecutionId string,
+) (*commandWaiting, bool, error) {
+	waiting, ok, err := decodeCommandWaiting(stateInput)
+	if err != nil {
+		return nil, false, err
+	}
+	if !ok {
+		// the first round, the request is from the internal command request queue
+		queueName := getInternalCommandRequestQueueName(stateExecutionId)
+		for _, r := range results.GetLocalQueueResults() {
+			if r.QueueName != queueName || len(r.Messages) == 0 {
+				continue
+			}
+			waiting, ok, err = decodeCommandWaiting(r.Messages[0].Payload)
+			if err != nil || !ok {
+				return nil, false, NewInternalError("invalid message of %v", queueName)
+			}
+			waiting.Input = stateInput
+			break
+		}
+	}
+	if !ok {
+		return nil, false, nil
+	}
+	if len(waiting.Results) == 0 {
+		waiting.Results = make([]commandWaitingResult, len(waiting.Request.getCommands()))
+		for i := range waiting.Results {
+			waiting.Results[i].Status = xcapi.WAITING_COMMAND
+		}
+	}
+	waiting.mergeResults(results)
+	return waiting, true, nil
+}
+
+// getPendingIndexes returns the indexes of the commands that are not completed,
+// which are waited in the next round
+func (w *commandWaiting) getPendingIndexes() []int {
+	var indexes []int
+	for i, r := range w.Results {
+		if r.Status != xcapi.COMPLETED_COMMAND {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// mergeResults merges the results of the round, the server returns the results of each command type
+// in the same order as the commands of the round
+func (w *commandWaiting) mergeResults(results *xcapi.CommandResults) {
+	commands := w.Request.getCommands()
+	timerIndex, localQIndex := 0, 0
+	for _, i := range w.getPendingIndexes() {
+		switch commands[i].CommandType {
+		case CommandTypeTimer:
+			if timerIndex < len(results.GetTimerResults()) {
+				w.Results[i].Status = results.TimerResults[timerIndex].Status
+			}
+			timerIndex++
+		case CommandTypeLocalQueue:
+			if localQIndex < len(results.GetLocalQueueResults()) {
+				r := results.LocalQueueResults[localQIndex]
+				w.Results[i] = commandWaitingResult{
+					Status:   r.Status,
+					Messages: r.Messages,
+				}
+			}
+			localQIndex++
+		}
+	}
+}
+
+// getApiCommandRequest returns the request to the server to wait for any of the pending commands,
+// the timers are shortened by the time that has been waited
+func (w *commandWaiting) getApiCommandRequest(now time.Time) (*xcapi.CommandRequest, error) {
+	commands := w.Request.getCommands()
+	var pending []Command
+	for _, i := range w.getPendingIndexes() {
+		cmd := commands[i]
+		if cmd.CommandType == CommandTypeTimer {
+			fireAt := time.UnixMilli(w.StartedAt).Add(time.Duration(cmd.TimerCommand.DelayInSeconds) * time.Second)
+			remaining := fireAt.Sub(now)
+			if remaining < 0 {
+				remaining = 0
+			}
+			// round up to seconds so that the timer doesn't fire earlier
+			cmd = NewTimerCommand((remaining + time.Second - 1).Truncate(time.Second))
+		}
+		pending = append(pending, cmd)
+	}
+	if len(pending) == 0 {
+		return nil, NewProcessDefinitionError("the CommandRequest can't be satisfied by the commands")
+	}
+	return toApiCommandRequest(&CommandRequest{
+		Commands:           pending,
+		CommandWaitingType: xcapi.ANY_OF_COMPLETION,
+	}, NewEmptyCommunicationSchema())
+}
+
+// getCommandResults returns the CommandResults of the Request in the same order as the commands,
+// with the commandIds
+func (w *commandWaiting) getCommandResults(encoder ObjectEncoder) CommandResults {
+	results := CommandResults{}
+	for i, cmd := range w.Request.getCommands() {
+		switch cmd.CommandType {
+		case CommandTypeTimer:
+			results.TimerResults = append(results.TimerResults, TimerResult{
+				CommandId: cmd.CommandId,
+				Status:    w.Results[i].Status,
+			})
+		case CommandTypeLocalQueue:
+			results.LocalQueueResults = append(results.LocalQueueResults, LocalQueueCommandResult{
+				CommandId: cmd.CommandId,
+				Result: xcapi.LocalQueueResult{
+					Status:    w.Results[i].Status,
+					QueueName: cmd.LocalQueueCommand.QueueName,
+					Messages:  w.Results[i].Messages,
+				},
+				Encoder: encoder,
+			})
+		}
+	}
+	return results
+}
+
+// getWaitingAgainDecision returns the decision to move to the same state to wait for the pending commands
+func (w *commandWaiting) getWaitingAgainDecision(
+	stateDef AsyncState, prcType string, registry Registry,
+) (*xcapi.StateDecision, error) {
+	if len(w.getPendingIndexes()) == 0 {
+		return nil, NewProcessDefinitionError("the CommandRequest can't be satisfied by the commands")
+	}
+	input, err := encodeCommandWaiting(w)
+	if err != nil {
+		return nil, err
+	}
+	return &xcapi.StateDecision{
+		NextStates: []xcapi.StateMovement{
+			{
+				StateId:     GetFinalStateId(stateDef),
+				StateInput:  input,
+				StateConfig: fromStateToAsyncStateConfig(stateDef, prcType, registry),
+			},
+		},
+	}, nil
+}
+
+func encodeCommandWaiting(waiting *commandWaiting) (*xcapi.EncodedObject, error) {
+	data, err := json.Marshal(waiting)
+	if err != nil {
+		return nil, err
+	}
+	return &xcapi.EncodedObject{
+		Encoding: encodingTypeCommandWaiting,
+		Data:     string(data),
+	}, nil
+}
+
+// decodeCommandWaiting returns false if the encoded object is not a commandWaiting
+func decodeCommandWaiting(encodedObj *xcapi.EncodedObject) (*commandWaiting, bool, error) {
+	if encodedObj == nil || encodedObj.GetEncoding() != encodingTypeCommandWaiting {
+		return nil, false, nil
+	}
+	waiting := &commandWaiting{}
+	if err := json.Unmarshal([]byte(encodedObj.GetData()), waiting); err != nil {
+		return nil, false, err
+	}
+	return waiting, true, nil
+}
@@ -0,0 +1,143 @@
+package xc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+type commandIdsTestProcess struct {
+	ProcessDefaults
+}
+
+func (p commandIdsTestProcess) GetAsyncStateSchema() StateSchema {
+	return NewStateSchema(&commandIdsTestState{})
+}
+
+var commandIdsTestResults = make(chan CommandResults, 1)
+
+type commandIdsTestState struct {
+	AsyncStateDefaults
+}
+
+func (s *commandIdsTestState) WaitUntil(ctx Context, input Object, communication Communication) (*CommandRequest, error) {
+	return AllOf(
+		NewTimerCommand(time.Minute).WithId("reminder"),
+		NewLocalQueueCommand("approvals", 1).WithId("approval"),
+	), nil
+}
+
+func (s *commandIdsTestState) Execute(
+	ctx Context, input Object, commandResults CommandResults, persistence Persistence, communication Communication,
+) (*StateDecision, error) {
+	var in string
+	input.Get(&in)
+	if in != "state-input" {
+		return nil, NewInvalidArgumentError("unexpected input %v", in)
+	}
+	commandIdsTestResults <- commandResults
+	return DeadEnd, nil
+}
+
+func TestCommandIdsWaitedByWorkerService(t *testing.T) {
+	registry := NewRegistry()
+	assert.Nil(t, registry.AddProcess(commandIdsTestProcess{}))
+	workerService := NewWorkerService(registry, nil)
+	ctx := context.Background()
+	prcType := GetFinalProcessType(commandIdsTestProcess{})
+	stateId := GetFinalStateId(&commandIdsTestState{})
+	encoder := GetDefaultObjectEncoder()
+	input, err := encoder.Encode("state-input")
+	assert.Nil(t, err)
+	message, err := encoder.Encode("approved")
+	assert.Nil(t, err)
+	apiCtx := xcapi.Context{
+		ProcessId:          "process-1",
+		ProcessExecutionId: "execution-1",
+		StateExecutionId:   xcapi.PtrString("state1-1"),
+	}
+
+	waitUntil := func(stateInput *xcapi.EncodedObject) *xcapi.AsyncStateWaitUntilResponse {
+		resp, err := workerService.HandleAsyncStateWaitUntil(ctx, xcapi.AsyncStateWaitUntilRequest{
+			Context:     apiCtx,
+			ProcessType: prcType,
+			StateId:     stateId,
+			StateInput:  stateInput,
+		})
+		assert.Nil(t, err)
+		return resp
+	}
+	execute := func(stateInput *xcapi.EncodedObject, results xcapi.CommandResults) *xcapi.AsyncStateExecuteResponse {
+		resp, err := workerService.HandleAsyncStateExecute(ctx, xcapi.AsyncStateExecuteRequest{
+			Context:        apiCtx,
+			ProcessType:    prcType,
+			StateId:        stateId,
+			StateInput:     stateInput,
+			CommandResults: &results,
+		})
+		assert.Nil(t, err)
+		return resp
+	}
+
+	// the first round completes immediately with the CommandRequest published to the internal queue
+	waitResp := waitUntil(input)
+	assert.Equal(t, xcapi.ANY_OF_COMPLETION, waitResp.CommandRequest.WaitingType)
+	assert.Equal(t, 1, len(waitResp.CommandRequest.TimerCommands))
+	assert.Equal(t, 2, len(waitResp.CommandRequest.LocalQueueCommands))
+	assert.Equal(t, 1, len(waitResp.PublishToLocalQueue))
+	assert.Equal(t, "xc.internal.commandRequest.state1-1", waitResp.PublishToLocalQueue[0].QueueName)
+	execResp := execute(input, xcapi.CommandResults{
+		TimerResults: []xcapi.TimerResult{{Status: xcapi.WAITING_COMMAND}},
+		LocalQueueResults: []xcapi.LocalQueueResult{
+			{QueueName: "approvals", Status: xcapi.WAITING_COMMAND},
+			{
+				QueueName: getInternalCommandRequestQueueName("state1-1"),
+				Status:    xcapi.COMPLETED_COMMAND,
+				Messages:  []xcapi.LocalQueueMessageResult{{Payload: waitResp.PublishToLocalQueue[0].Payload}},
+			},
+		},
+	})
+	assert.Equal(t, stateId, execResp.StateDecision.NextStates[0].StateId)
+
+	// the second round receives the message
+	waiting := execResp.StateDecision.NextStates[0].StateInput
+	waitResp = waitUntil(waiting)
+	assert.Equal(t, 1, len(waitResp.CommandRequest.TimerCommands))
+	assert.LessOrEqual(t, waitResp.CommandRequest.TimerCommands[0].DelayInSeconds, int64(60))
+	assert.Equal(t, 1, len(waitResp.CommandRequest.LocalQueueCommands))
+	execResp = execute(waiting, xcapi.CommandResults{
+		TimerResults: []xcapi.TimerResult{{Status: xcapi.WAITING_COMMAND}},
+		LocalQueueResults: []xcapi.LocalQueueResult{
+			{
+				QueueName: "approvals",
+				Status:    xcapi.COMPLETED_COMMAND,
+				Messages:  []xcapi.LocalQueueMessageResult{{Payload: message}},
+			},
+		},
+	})
+	assert.Empty(t, commandIdsTestResults)
+
+	// the third round only waits for the timer, then invokes the Execute
+	waiting = execResp.StateDecision.NextStates[0].StateInput
+	waitResp = waitUntil(waiting)
+	assert.Equal(t, 1, len(waitResp.CommandRequest.TimerCommands))
+	assert.Empty(t, waitResp.CommandRequest.LocalQueueCommands)
+	execResp = execute(waiting, xcapi.CommandResults{
+		TimerResults: []xcapi.TimerResult{{Status: xcapi.COMPLETED_COMMAND}},
+	})
+	assert.NotNil(t, execResp.StateDecision.ThreadCloseDecision)
+
+	results := <-commandIdsTestResults
+	assert.True(t, results.IsCommandFired("reminder"))
+	assert.Equal(t, []string{"reminder", "approval"}, results.FiredCommandIds())
+	approval, ok := results.GetLocalQueueResultById("approval")
+	assert.True(t, ok)
+	var msg string
+	approval.GetFirstMessage(&msg)
+	assert.Equal(t, "approved", msg)
+	assert.Equal(t, xcapi.CommandStatus(""), results.GetTimerStatus(1))
+	assert.Empty(t, results.GetLocalQueueCommand(1).GetMessages())
+}
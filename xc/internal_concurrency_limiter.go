@@ -0,0 +1,103 @@
+package xc
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+type concurrencyLimiter struct {
+	options        WorkerConcurrencyOptions
+	global         chan struct{}
+	perProcessType map[string]chan struct{}
+	perState       map[string]chan struct{}
+	queued         int64
+}
+
+// newConcurrencyLimiter returns nil if options is nil, which means no limit
+func newConcurrencyLimiter(options *WorkerConcurrencyOptions) *concurrencyLimiter {
+	if options == nil {
+		return nil
+	}
+	l := &concurrencyLimiter{
+		options:        *options,
+		perProcessType: map[string]chan struct{}{},
+		perState:       map[string]chan struct{}{},
+	}
+	if l.options.RetryAfter == 0 {
+		l.options.RetryAfter = DefaultWorkerOverloadedRetryAfter
+	}
+	if options.MaxConcurrentRequests > 0 {
+		l.global = make(chan struct{}, options.MaxConcurrentRequests)
+	}
+	for prcType, limit := range options.MaxConcurrentRequestsPerProcessType {
+		if limit > 0 {
+			l.perProcessType[prcType] = make(chan struct{}, limit)
+		}
+	}
+	for stateKey, limit := range options.MaxConcurrentRequestsPerState {
+		if limit > 0 {
+			l.perState[stateKey] = make(chan struct{}, limit)
+		}
+	}
+	return l
+}
+
+// acquire acquires the slots for the request, the returned release function must be called after the invocation
+func (l *concurrencyLimiter) acquire(ctx context.Context, prcType, stateId string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	var acquired []chan struct{}
+	release := func() {
+		for _, sem := range acquired {
+			<-sem
+		}
+	}
+
+	var deadline <-chan time.Time
+	if l.options.MaxQueueingDuration > 0 {
+		timer := time.NewTimer(l.options.MaxQueueingDuration)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	// acquire the most specific slot first, so that a request waiting for a busy state doesn't hold
+	// the slots of the process type and the worker, which would block the requests of the other states
+	for _, sem := range []chan struct{}{
+		l.perState[GetWorkerConcurrencyStateKey(prcType, stateId)], l.perProcessType[prcType], l.global,
+	} {
+		if sem == nil {
+			continue
+		}
+		if err := l.acquireOne(ctx, sem, deadline); err != nil {
+			release()
+			return nil, err
+		}
+		acquired = append(acquired, sem)
+	}
+	return release, nil
+}
+
+func (l *concurrencyLimiter) acquireOne(ctx context.Context, sem chan struct{}, deadline <-chan time.Time) error {
+	select {
+	case sem <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if atomic.AddInt64(&l.queued, 1) > int64(l.options.MaxQueuedRequests) {
+		atomic.AddInt64(&l.queued, -1)
+		return NewWorkerOverloadedError("too many requests in queue", l.options.RetryAfter)
+	}
+	defer atomic.AddInt64(&l.queued, -1)
+
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-deadline:
+		return NewWorkerOverloadedError("timeout waiting in queue", l.options.RetryAfter)
+	case <-ctx.Done():
+		return NewWorkerOverloadedError("request is cancelled while waiting in queue", l.options.RetryAfter)
+	}
+}
@@ -0,0 +1,94 @@
+package xc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrencyLimiter(t *testing.T) {
+	limiter := newConcurrencyLimiter(&WorkerConcurrencyOptions{
+		MaxConcurrentRequests:         3,
+		MaxConcurrentRequestsPerState: map[string]int{GetWorkerConcurrencyStateKey("prc", "state1"): 1},
+		MaxQueuedRequests:             1,
+		MaxQueueingDuration:           time.Millisecond * 100,
+	})
+
+	release1, err := limiter.acquire(context.Background(), "prc", "state1")
+	assert.Nil(t, err)
+
+	// queued and timed out waiting for state1
+	start := time.Now()
+	_, err = limiter.acquire(context.Background(), "prc", "state1")
+	assert.True(t, IsWorkerOverloadedError(err))
+	assert.True(t, time.Since(start) >= time.Millisecond*100)
+
+	// other states are not blocked by state1
+	release2, err := limiter.acquire(context.Background(), "prc", "state2")
+	assert.Nil(t, err)
+	release3, err := limiter.acquire(context.Background(), "prc", "state2")
+	assert.Nil(t, err)
+
+	// global limit is reached, one is queued and the other is rejected immediately
+	done := make(chan error)
+	go func() {
+		release, err := limiter.acquire(context.Background(), "prc", "state2")
+		if err == nil {
+			release()
+		}
+		done <- err
+	}()
+	time.Sleep(time.Millisecond * 10)
+	_, err = limiter.acquire(context.Background(), "prc", "state2")
+	assert.True(t, IsWorkerOverloadedError(err))
+	retryAfter, ok := GetRetryAfterSeconds(err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, retryAfter)
+
+	release1()
+	assert.Nil(t, <-done)
+	release2()
+	release3()
+
+	// no limit
+	var noLimiter *concurrencyLimiter
+	release, err := noLimiter.acquire(context.Background(), "prc", "state1")
+	assert.Nil(t, err)
+	release()
+}
+
+func TestConcurrencyLimiterWaitingForStateNotHoldingGlobal(t *testing.T) {
+	limiter := newConcurrencyLimiter(&WorkerConcurrencyOptions{
+		MaxConcurrentRequests:         2,
+		MaxConcurrentRequestsPerState: map[string]int{GetWorkerConcurrencyStateKey("prc", "state1"): 1},
+		MaxQueuedRequests:             1,
+		MaxQueueingDuration:           time.Second,
+	})
+
+	release1, err := limiter.acquire(context.Background(), "prc", "state1")
+	assert.Nil(t, err)
+
+	// waiting for state1 without holding a global slot
+	done := make(chan error)
+	go func() {
+		release, err := limiter.acquire(context.Background(), "prc", "state1")
+		if err == nil {
+			release()
+		}
+		done <- err
+	}()
+	time.Sleep(time.Millisecond * 10)
+
+	release2, err := limiter.acquire(context.Background(), "prc", "state2")
+	assert.Nil(t, err)
+	release2()
+	// the same stateId of another process type is not limited
+	release3, err := limiter.acquire(context.Background(), "prc2", "state1")
+	assert.Nil(t, err)
+	release3()
+
+	release1()
+	assert.Nil(t, <-done)
+}
@@ -5,13 +5,22 @@ import (
 	"github.com/xcherryio/sdk-go/xc/ptr"
 )
 
-func toApiCommandRequest(request *CommandRequest) (*xcapi.CommandRequest, error) {
+func toApiCommandRequest(
+	request *CommandRequest, commSchema CommunicationSchema,
+) (*xcapi.CommandRequest, error) {
 	if request == nil {
 		return nil, NewProcessDefinitionError("command request cannot be nil")
 	}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	commands, waitingType, ok := request.flatten()
+	if !ok {
+		return nil, NewProcessDefinitionError("the combination of commands must be waited by the WorkerService")
+	}
 	var timerCmds []xcapi.TimerCommand
 	var localQCmds []xcapi.LocalQueueCommand
-	for _, t := range request.Commands {
+	for _, t := range commands {
 		switch t.CommandType {
 		case CommandTypeTimer:
 			timerCmd := xcapi.TimerCommand{
@@ -19,6 +28,9 @@ func toApiCommandRequest(request *CommandRequest) (*xcapi.CommandRequest, error)
 			}
 			timerCmds = append(timerCmds, timerCmd)
 		case CommandTypeLocalQueue:
+			if err := commSchema.ValidateLocalQueueCommand(*t.LocalQueueCommand); err != nil {
+				return nil, err
+			}
 			localQCommand := xcapi.LocalQueueCommand{
 				QueueName: t.LocalQueueCommand.QueueName,
 				Count:     ptr.Any(int32(t.LocalQueueCommand.Count)),
@@ -30,7 +42,7 @@ func toApiCommandRequest(request *CommandRequest) (*xcapi.CommandRequest, error)
 
 	}
 	return &xcapi.CommandRequest{
-			WaitingType:        request.CommandWaitingType,
+			WaitingType:        waitingType,
 			TimerCommands:      timerCmds,
 			LocalQueueCommands: localQCmds,
 		},
@@ -74,11 +86,23 @@ func toApiDecision(
 		return nil, NewProcessDefinitionError("cannot have both next state and closing in a single decision")
 	}
 
+	if decision.CloseResult != nil && decision.ThreadCloseType == nil {
+		return nil, NewProcessDefinitionError("cannot have CloseResult without closing")
+	}
+
 	if decision.ThreadCloseType != nil {
+		closeDecision := &xcapi.ThreadCloseDecision{
+			CloseType: *decision.ThreadCloseType,
+		}
+		if decision.CloseResult != nil {
+			closeInput, err := encoder.Encode(decision.CloseResult)
+			if err != nil {
+				return nil, err
+			}
+			closeDecision.CloseInput = closeInput
+		}
 		return &xcapi.StateDecision{
-			ThreadCloseDecision: &xcapi.ThreadCloseDecision{
-				CloseType: *decision.ThreadCloseType,
-			},
+			ThreadCloseDecision: closeDecision,
 		}, nil
 	}
 
@@ -196,9 +220,11 @@ func createLoadLocalAttributesRequestIfNeeded(
 		keysToLoadNoLock = append(keysToLoadNoLock, key)
 	}
 
+	// the old keys are loaded as well, to move their values to the keys
+	localAttributeSchema := persistenceSchema.LocalAttributeSchema
 	return &xcapi.LoadLocalAttributesRequest{
 		LockType:           localAttributePolicy.LockingType,
-		KeysToLoadNoLock:   keysToLoadNoLock,
-		KeysToLoadWithLock: keysToLoadWithLock,
+		KeysToLoadNoLock:   getLocalAttributeKeysToLoad(localAttributeSchema, keysToLoadNoLock),
+		KeysToLoadWithLock: getLocalAttributeKeysToLoad(localAttributeSchema, keysToLoadWithLock),
 	}
 }
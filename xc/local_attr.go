@@ -0,0 +1,117 @@
+package xc
+
+import "time"
+
+// LocalAttributeDeclaration declares a local attribute in the LocalAttributesSchema,
+// it's either a LocalAttributeDef or a typed LocalAttr
+type LocalAttributeDeclaration interface {
+	GetLocalAttributeDef() LocalAttributeDef
+}
+
+// LocalAttr is a typed key of a local attribute, which is declared once and used to get/set the attribute
+// with the value type checked at compile time, e.g.
+//
+//	var countAttr = xc.NewLocalAttr[int]("count", xc.LoadNoLock).WithDefault(0)
+//
+//	func (p myProcess) GetPersistenceSchema() xc.PersistenceSchema {
+//		return xc.NewPersistenceSchemaWithOptions(xc.NewLocalAttributesSchemaFromDeclarations(nil, countAttr))
+//	}
+//
+//	count, _ := countAttr.Get(persistence)
+//	countAttr.Set(persistence, count+1)
+type LocalAttr[T any] struct {
+	def          LocalAttributeDef
+	defaultValue T
+	hasDefault   bool
+}
+
+// NewLocalAttr returns a LocalAttr of the value type T
+func NewLocalAttr[T any](key string, defaultLoadingType LocalAttributeLoadingType) LocalAttr[T] {
+	return LocalAttr[T]{
+		def: NewLocalAttributeDef(key, defaultLoadingType),
+	}
+}
+
+// WithDefault returns a copy of the LocalAttr with the default value
+func (a LocalAttr[T]) WithDefault(defaultValue T) LocalAttr[T] {
+	a.def = a.def.WithDefault(defaultValue)
+	a.defaultValue = defaultValue
+	a.hasDefault = true
+	return a
+}
+
+// WithTTL returns a copy of the LocalAttr with the TTL
+func (a LocalAttr[T]) WithTTL(ttl time.Duration) LocalAttr[T] {
+	a.def = a.def.WithTTL(ttl)
+	return a
+}
+
+// WithAliases returns a copy of the LocalAttr with the old keys that the attribute is renamed from
+func (a LocalAttr[T]) WithAliases(oldKeys ...string) LocalAttr[T] {
+	a.def = a.def.WithAliases(oldKeys...)
+	return a
+}
+
+// WithVersion returns a copy of the LocalAttr with the current version of the value,
+// and the function to upgrade the values of older versions to T when loaded
+func (a LocalAttr[T]) WithVersion(version int, upgrade func(fromVersion int, oldValue Object) (T, error)) LocalAttr[T] {
+	a.def = a.def.WithVersion(version, func(fromVersion int, oldValue Object) (interface{}, error) {
+		return upgrade(fromVersion, oldValue)
+	})
+	return a
+}
+
+// GetKey returns the key of the local attribute
+func (a LocalAttr[T]) GetKey() string {
+	return a.def.Key
+}
+
+func (a LocalAttr[T]) GetLocalAttributeDef() LocalAttributeDef {
+	return a.def
+}
+
+// Get returns the value of the local attribute, or the default value if it's not set.
+// The bool is false if neither the value nor the default value is present. Panics on error
+func (a LocalAttr[T]) Get(persistence Persistence) (T, bool) {
+	val, ok, err := a.GetE(persistence)
+	if err != nil {
+		panic(err)
+	}
+	return val, ok
+}
+
+// GetE is the same as Get, but returns error instead of panic
+func (a LocalAttr[T]) GetE(persistence Persistence) (T, bool, error) {
+	var val T
+	if !persistence.HasLocalAttribute(a.def.Key) {
+		if a.hasDefault {
+			return a.defaultValue, true, nil
+		}
+		// still read it so that an unregistered key is returned as error
+		return val, false, persistence.GetLocalAttributeE(a.def.Key, &val)
+	}
+	if err := persistence.GetLocalAttributeE(a.def.Key, &val); err != nil {
+		return val, false, err
+	}
+	return val, true, nil
+}
+
+// Set sets the value of the local attribute, panics on error
+func (a LocalAttr[T]) Set(persistence Persistence, value T) {
+	persistence.SetLocalAttribute(a.def.Key, value)
+}
+
+// SetE is the same as Set, but returns error instead of panic
+func (a LocalAttr[T]) SetE(persistence Persistence, value T) error {
+	return persistence.SetLocalAttributeE(a.def.Key, value)
+}
+
+// Delete deletes the value of the local attribute, panics on error
+func (a LocalAttr[T]) Delete(persistence Persistence) {
+	persistence.DeleteLocalAttribute(a.def.Key)
+}
+
+// DeleteE is the same as Delete, but returns error instead of panic
+func (a LocalAttr[T]) DeleteE(persistence Persistence) error {
+	return persistence.DeleteLocalAttributeE(a.def.Key)
+}
@@ -0,0 +1,67 @@
+package xc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testLocalAttrValue struct {
+	Name  string
+	Count int
+}
+
+var (
+	testCountAttr  = NewLocalAttr[int]("count", LoadNoLock).WithDefault(5)
+	testStructAttr = NewLocalAttr[testLocalAttrValue]("struct", LoadNoLock)
+	testTTLAttr    = NewLocalAttr[string]("ttl", NotLoad).WithTTL(time.Minute)
+)
+
+func TestLocalAttrRegisteredInSchema(t *testing.T) {
+	schema := NewLocalAttributesSchemaFromDeclarations(nil,
+		testCountAttr, testStructAttr, testTTLAttr, NewLocalAttributeDef("raw", LoadNoLock))
+
+	assert.Equal(t, map[string]bool{"count": true, "struct": true, "ttl": true, "raw": true}, schema.LocalAttributeKeys)
+	assert.Equal(t, map[string]interface{}{"count": 5}, schema.LocalAttributeDefaults)
+	assert.Equal(t, map[string]time.Duration{"ttl": time.Minute}, schema.LocalAttributeTTLs)
+	assert.Equal(t,
+		map[string]bool{"count": true, "struct": true, "raw": true},
+		schema.DefaultLocalAttributePolicy.LocalAttributeKeysNoLock)
+
+	assert.Panics(t, func() {
+		NewLocalAttributesSchemaFromDeclarations(nil, testCountAttr, NewLocalAttributeDef("count", LoadNoLock))
+	})
+}
+
+func TestLocalAttrGetSet(t *testing.T) {
+	p := NewPersistenceImplWithSchema(NewLocalAttributesSchemaFromDeclarations(nil, testCountAttr, testStructAttr), nil)
+
+	count, ok := testCountAttr.Get(p)
+	assert.True(t, ok)
+	assert.Equal(t, 5, count)
+	testCountAttr.Set(p, count+1)
+	count, ok = testCountAttr.Get(p)
+	assert.True(t, ok)
+	assert.Equal(t, 6, count)
+
+	val, ok := testStructAttr.Get(p)
+	assert.False(t, ok)
+	assert.Equal(t, testLocalAttrValue{}, val)
+	testStructAttr.Set(p, testLocalAttrValue{Name: "a", Count: 1})
+	val, ok = testStructAttr.Get(p)
+	assert.True(t, ok)
+	assert.Equal(t, testLocalAttrValue{Name: "a", Count: 1}, val)
+
+	testStructAttr.Delete(p)
+	_, ok = testStructAttr.Get(p)
+	assert.False(t, ok)
+
+	_, _, err := testTTLAttr.GetE(p)
+	assert.Error(t, err)
+	assert.Error(t, testTTLAttr.SetE(p, "value"))
+	assert.Error(t, testTTLAttr.DeleteE(p))
+	assert.Nil(t, testCountAttr.DeleteE(p))
+	count, _ = testCountAttr.Get(p)
+	assert.Equal(t, 5, count)
+}
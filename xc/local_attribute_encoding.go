@@ -0,0 +1,217 @@
+package xc
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+// The server has no TTL or schema version for local attributes, so they are carried in an envelope
+// as the data of the value, e.g. {"xcLocalAttribute":{"expireAt":1700000000,"version":2},"data":"\"value\""}.
+// The encoding of the value is kept as is.
+const localAttributeEnvelopePrefix = `{"xcLocalAttribute":`
+
+type localAttributeEnvelope struct {
+	Metadata localAttributeEnvelopeMetadata `json:"xcLocalAttribute"`
+	Data     string                         `json:"data"`
+}
+
+type localAttributeEnvelopeMetadata struct {
+	ExpireAt int64 `json:"expireAt,omitempty"`
+	Version  int   `json:"version,omitempty"`
+}
+
+type localAttributeMetadata struct {
+	expireAt    time.Time
+	hasExpireAt bool
+	version     int
+}
+
+func (m localAttributeMetadata) isExpired(now time.Time) bool {
+	return m.hasExpireAt && !now.Before(m.expireAt)
+}
+
+// unwrapLocalAttribute returns the encoded value without the envelope, and the metadata of the envelope
+func unwrapLocalAttribute(encoded xcapi.EncodedObject) (xcapi.EncodedObject, localAttributeMetadata) {
+	var meta localAttributeMetadata
+	if !strings.HasPrefix(encoded.Data, localAttributeEnvelopePrefix) {
+		return encoded, meta
+	}
+	var envelope localAttributeEnvelope
+	if err := json.Unmarshal([]byte(encoded.Data), &envelope); err != nil {
+		return encoded, meta
+	}
+	encoded.Data = envelope.Data
+	if envelope.Metadata.ExpireAt > 0 {
+		meta.expireAt = time.Unix(envelope.Metadata.ExpireAt, 0)
+		meta.hasExpireAt = true
+	}
+	meta.version = envelope.Metadata.Version
+	return encoded, meta
+}
+
+// wrapLocalAttribute returns a copy of the encoded value with the metadata carried in the envelope,
+// or the value as is if there is no metadata
+func wrapLocalAttribute(encoded xcapi.EncodedObject, meta localAttributeMetadata) (xcapi.EncodedObject, error) {
+	encoded, _ = unwrapLocalAttribute(encoded)
+	envelope := localAttributeEnvelope{
+		Data: encoded.Data,
+	}
+	if meta.hasExpireAt {
+		envelope.Metadata.ExpireAt = meta.expireAt.Unix()
+	}
+	envelope.Metadata.Version = meta.version
+	if envelope.Metadata == (localAttributeEnvelopeMetadata{}) {
+		return encoded, nil
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return encoded, err
+	}
+	encoded.Data = string(data)
+	return encoded, nil
+}
+
+// encodeLocalAttribute encodes the local attribute value with the encoder of the process,
+// with the expiry if the key has a TTL and the version if the key has a version in the schema
+func encodeLocalAttribute(
+	schema *LocalAttributesSchema, encoder ObjectEncoder, key string, value interface{}, now time.Time,
+) (*xcapi.EncodedObject, error) {
+	encoded, err := encodeObject(encoder, value)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil || encoded.GetData() == "" {
+		return encoded, nil
+	}
+	var meta localAttributeMetadata
+	if ttl, ok := schema.LocalAttributeTTLs[key]; ok {
+		meta.expireAt = now.Add(ttl)
+		meta.hasExpireAt = true
+	}
+	meta.version = schema.LocalAttributeVersions[key]
+	wrapped, err := wrapLocalAttribute(*encoded, meta)
+	if err != nil {
+		return nil, err
+	}
+	return &wrapped, nil
+}
+
+// getLocalAttributeDecoder returns the encoder of the process to decode the local attribute value,
+// or the default encoder for the values encoded by it, which is how the local attributes were encoded
+// before they were encoded by the ObjectEncoder of the options
+func getLocalAttributeDecoder(encoder ObjectEncoder, encoded *xcapi.EncodedObject) ObjectEncoder {
+	if encoded.GetEncoding() == GetDefaultObjectEncoder().GetEncodingType() {
+		return GetDefaultObjectEncoder()
+	}
+	return encoder
+}
+
+// getLocalAttributeKeysToLoad returns the keys with their aliases, so that the values of old keys are loaded
+func getLocalAttributeKeysToLoad(schema *LocalAttributesSchema, keys []string) []string {
+	res := append([]string{}, keys...)
+	if schema == nil {
+		return res
+	}
+	requested := map[string]bool{}
+	for _, key := range keys {
+		requested[key] = true
+	}
+	for _, alias := range getSortedKeys(schema.LocalAttributeAliases) {
+		if requested[schema.LocalAttributeAliases[alias]] {
+			res = append(res, alias)
+		}
+	}
+	return res
+}
+
+// loadLocalAttributes applies the schema to the local attributes loaded from the server:
+// the values of the aliases are moved to the keys, the deprecated and unknown keys are skipped,
+// the expired values are deleted, and the old versions are upgraded.
+// Returns the current values, the writes to persist the changes, and the unknown keys that are skipped.
+// The changes are only written back for the keys loaded with lock, because a concurrent thread may write
+// the other keys after they are loaded, so the other keys are migrated in memory every time they are loaded
+func loadLocalAttributes(
+	schema *LocalAttributesSchema, encoder ObjectEncoder, loaded []xcapi.KeyValue, now time.Time,
+) (curr map[string]xcapi.EncodedObject, updates map[string]xcapi.EncodedObject, unknownKeys []string, err error) {
+	curr = map[string]xcapi.EncodedObject{}
+	updates = map[string]xcapi.EncodedObject{}
+	writeBack := func(key, updatedKey string, value xcapi.EncodedObject) {
+		if isLocalAttributeLoadedWithLock(schema, key) {
+			updates[updatedKey] = value
+		}
+	}
+
+	var aliased []xcapi.KeyValue
+	for _, kv := range loaded {
+		if _, ok := schema.LocalAttributeKeys[kv.Key]; ok {
+			curr[kv.Key] = kv.Value
+		} else if _, ok := schema.LocalAttributeAliases[kv.Key]; ok {
+			aliased = append(aliased, kv)
+		} else if !schema.DeprecatedLocalAttributeKeys[kv.Key] {
+			unknownKeys = append(unknownKeys, kv.Key)
+		}
+	}
+	for _, kv := range aliased {
+		key := schema.LocalAttributeAliases[kv.Key]
+		if kv.Value.GetData() == "" {
+			continue
+		}
+		// the value of the old key is deleted once it's moved, or if the new key is already set
+		writeBack(key, kv.Key, xcapi.EncodedObject{})
+		if cur, ok := curr[key]; ok && cur.GetData() != "" {
+			continue
+		}
+		curr[key] = kv.Value
+		writeBack(key, key, kv.Value)
+	}
+
+	for key, val := range curr {
+		if val.GetData() == "" {
+			continue
+		}
+		stripped, meta := unwrapLocalAttribute(val)
+		if meta.isExpired(now) {
+			curr[key] = xcapi.EncodedObject{}
+			writeBack(key, key, xcapi.EncodedObject{})
+			continue
+		}
+		version := schema.LocalAttributeVersions[key]
+		if meta.version >= version {
+			continue
+		}
+		upgrade, ok := schema.LocalAttributeUpgrades[key]
+		if !ok {
+			return nil, nil, nil, NewInvalidArgumentError("no upgrade function for local attribute %v of version %v", key, version)
+		}
+		upgraded, err := upgrade(meta.version, NewObject(&stripped, getLocalAttributeDecoder(encoder, &stripped)))
+		if err != nil {
+			return nil, nil, nil, NewInvalidArgumentError(
+				"failed to upgrade local attribute %v from version %v: %v", key, meta.version, err)
+		}
+		encoded, err := encodeObject(encoder, upgraded)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		meta.version = version
+		if encoded.GetData() == "" {
+			meta = localAttributeMetadata{}
+		}
+		wrapped, err := wrapLocalAttribute(*encoded, meta)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		curr[key] = wrapped
+		writeBack(key, key, wrapped)
+	}
+	return curr, updates, unknownKeys, nil
+}
+
+// isLocalAttributeLoadedWithLock returns true if the key(and its aliases) is loaded with a lock
+func isLocalAttributeLoadedWithLock(schema *LocalAttributesSchema, key string) bool {
+	policy := schema.DefaultLocalAttributePolicy
+	return policy.LocalAttributeKeysWithLock[key] &&
+		policy.LockingType != nil && *policy.LockingType != xcapi.NO_LOCKING
+}
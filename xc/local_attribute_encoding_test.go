@@ -0,0 +1,193 @@
+package xc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+type orderStatusV1 struct {
+	State   string
+	Shipped bool
+}
+
+type migrationTestProcess struct {
+	ProcessDefaults
+	schema *LocalAttributesSchema
+}
+
+func (p migrationTestProcess) GetAsyncStateSchema() StateSchema {
+	return NewStateSchema(&statefulTestState{})
+}
+
+func (p migrationTestProcess) GetPersistenceSchema() PersistenceSchema {
+	return NewPersistenceSchemaWithOptions(p.schema)
+}
+
+// the schema of the new version: "orderStatus" string is renamed to "status" orderStatusV1,
+// and "legacyNote" is no longer used. The migrations of "status" are written back only if it's loaded with lock
+func newMigrationTestSchema(statusLoadingType LocalAttributeLoadingType) *LocalAttributesSchema {
+	return NewLocalAttributesSchemaFromDeclarations(xcapi.EXCLUSIVE_LOCK.Ptr(),
+		NewLocalAttr[orderStatusV1]("status", statusLoadingType).
+			WithAliases("orderStatus").
+			WithVersion(1, func(fromVersion int, oldValue Object) (orderStatusV1, error) {
+				var state string
+				if err := oldValue.GetE(&state); err != nil {
+					return orderStatusV1{}, err
+				}
+				if state == "" {
+					return orderStatusV1{}, fmt.Errorf("empty state")
+				}
+				return orderStatusV1{State: state, Shipped: state == "shipped"}, nil
+			}),
+		NewLocalAttributeDef("count", LoadNoLock),
+		NewDeprecatedLocalAttributeDef("legacyNote"),
+	)
+}
+
+func newTestKeyValue(t *testing.T, key string, value interface{}, version int) xcapi.KeyValue {
+	encoded, err := GetDefaultObjectEncoder().Encode(value)
+	assert.Nil(t, err)
+	wrapped, err := wrapLocalAttribute(*encoded, localAttributeMetadata{version: version})
+	assert.Nil(t, err)
+	return *xcapi.NewKeyValue(key, wrapped)
+}
+
+func assertLocalAttributeVersion(t *testing.T, version int, encoded xcapi.EncodedObject) {
+	assert.Equal(t, "golangJson", encoded.Encoding)
+	_, meta := unwrapLocalAttribute(encoded)
+	assert.Equal(t, version, meta.version)
+}
+
+func TestLocalAttributeMigrationOfOldProcess(t *testing.T) {
+	schema := newMigrationTestSchema(LoadWithLock)
+	_, err := NewPersistenceSchemaWithOptions(schema).ValidateLocalAttributeForRegistry()
+	assert.Nil(t, err)
+
+	// a process started by the old version of the worker
+	p := newTestPersistenceImpl(schema, &xcapi.LoadLocalAttributesResponse{
+		Attributes: []xcapi.KeyValue{
+			newTestKeyValue(t, "orderStatus", "shipped", 0),
+			newTestKeyValue(t, "count", 3, 0),
+			newTestKeyValue(t, "legacyNote", "note", 0),
+			newTestKeyValue(t, "unknown", "value", 0),
+		},
+	}, time.Now)
+
+	var status orderStatusV1
+	p.GetLocalAttribute("status", &status)
+	assert.Equal(t, orderStatusV1{State: "shipped", Shipped: true}, status)
+	var count int
+	p.GetLocalAttribute("count", &count)
+	assert.Equal(t, 3, count)
+	assert.Error(t, p.GetLocalAttributeE("legacyNote", &count))
+	// the deprecated key is skipped silently, and the unknown key is returned to the caller
+	assert.Equal(t, []string{"unknown"}, p.GetUnknownLocalAttributeKeys())
+
+	updated := getTestLocalAttributesToUpdate(p)
+	assert.Equal(t, 2, len(updated))
+	assert.Equal(t, xcapi.EncodedObject{}, updated["orderStatus"])
+	assertLocalAttributeVersion(t, 1, updated["status"])
+
+	// the keys loaded without lock are migrated in memory only
+	p = newTestPersistenceImpl(newMigrationTestSchema(LoadNoLock), &xcapi.LoadLocalAttributesResponse{
+		Attributes: []xcapi.KeyValue{newTestKeyValue(t, "orderStatus", "shipped", 0)},
+	}, time.Now)
+	p.GetLocalAttribute("status", &status)
+	assert.Equal(t, orderStatusV1{State: "shipped", Shipped: true}, status)
+	assert.Empty(t, getTestLocalAttributesToUpdate(p))
+}
+
+func TestLocalAttributeMigrationOfNewProcess(t *testing.T) {
+	schema := newMigrationTestSchema(LoadWithLock)
+
+	p := newTestPersistenceImpl(schema, nil, time.Now)
+	p.SetLocalAttribute("status", orderStatusV1{State: "created"})
+	updated := getTestLocalAttributesToUpdate(p)
+	assertLocalAttributeVersion(t, 1, updated["status"])
+
+	// loading the values written by the new version doesn't write anything back
+	p = newTestPersistenceImpl(schema, &xcapi.LoadLocalAttributesResponse{
+		Attributes: []xcapi.KeyValue{*xcapi.NewKeyValue("status", updated["status"])},
+	}, time.Now)
+	var status orderStatusV1
+	p.GetLocalAttribute("status", &status)
+	assert.Equal(t, orderStatusV1{State: "created"}, status)
+	assert.Empty(t, getTestLocalAttributesToUpdate(p))
+}
+
+func TestLocalAttributeMigrationOfMixedProcess(t *testing.T) {
+	schema := newMigrationTestSchema(LoadWithLock)
+
+	// the old key is written by an old worker after the new key is written by a new worker
+	p := newTestPersistenceImpl(schema, &xcapi.LoadLocalAttributesResponse{
+		Attributes: []xcapi.KeyValue{
+			newTestKeyValue(t, "status", orderStatusV1{State: "created"}, 1),
+			newTestKeyValue(t, "orderStatus", "shipped", 0),
+		},
+	}, time.Now)
+	var status orderStatusV1
+	p.GetLocalAttribute("status", &status)
+	assert.Equal(t, orderStatusV1{State: "created"}, status)
+	assert.Equal(t, map[string]xcapi.EncodedObject{"orderStatus": {}}, getTestLocalAttributesToUpdate(p))
+
+	// the new key is written without version by an old worker that had the same key
+	p = newTestPersistenceImpl(schema, &xcapi.LoadLocalAttributesResponse{
+		Attributes: []xcapi.KeyValue{newTestKeyValue(t, "status", "created", 0)},
+	}, time.Now)
+	p.GetLocalAttribute("status", &status)
+	assert.Equal(t, orderStatusV1{State: "created"}, status)
+
+	_, err := newPersistenceImpl(schema, &xcapi.LoadLocalAttributesResponse{
+		Attributes: []xcapi.KeyValue{newTestKeyValue(t, "status", 1, 0)},
+	}, GetDefaultObjectEncoder(), time.Now)
+	assert.Error(t, err)
+}
+
+func TestLocalAttributeEnvelope(t *testing.T) {
+	encoded := xcapi.EncodedObject{Encoding: "golangJson", Data: `"value"`}
+	wrapped, err := wrapLocalAttribute(encoded, localAttributeMetadata{})
+	assert.Nil(t, err)
+	assert.Equal(t, encoded, wrapped)
+
+	meta := localAttributeMetadata{expireAt: time.Unix(1700000000, 0), hasExpireAt: true, version: 2}
+	wrapped, err = wrapLocalAttribute(encoded, meta)
+	assert.Nil(t, err)
+	assert.Equal(t, "golangJson", wrapped.Encoding)
+	assert.Equal(t, `{"xcLocalAttribute":{"expireAt":1700000000,"version":2},"data":"\"value\""}`, wrapped.Data)
+	unwrapped, parsed := unwrapLocalAttribute(wrapped)
+	assert.Equal(t, encoded, unwrapped)
+	assert.Equal(t, meta, parsed)
+
+	// a value that only looks like an envelope is kept as is
+	notEnvelope := xcapi.EncodedObject{Encoding: "golangJson", Data: `{"xcLocalAttribute":`}
+	unwrapped, _ = unwrapLocalAttribute(notEnvelope)
+	assert.Equal(t, notEnvelope, unwrapped)
+}
+
+func TestLocalAttributeMigrationLoadingRequest(t *testing.T) {
+	schema := newMigrationTestSchema(LoadWithLock)
+	registry := NewRegistry()
+	assert.Nil(t, registry.AddProcess(migrationTestProcess{schema: schema}))
+	req := createLoadLocalAttributesRequestIfNeeded(registry, GetFinalProcessType(migrationTestProcess{}), nil)
+	assert.ElementsMatch(t, []string{"status", "orderStatus"}, req.KeysToLoadWithLock)
+	assert.Equal(t, []string{"count"}, req.KeysToLoadNoLock)
+
+	assert.Panics(t, func() {
+		NewLocalAttributesSchema(nil,
+			NewLocalAttributeDef("a", LoadNoLock).WithAliases("old"),
+			NewLocalAttributeDef("b", LoadNoLock).WithAliases("old"))
+	})
+	_, err := NewPersistenceSchemaWithOptions(NewLocalAttributesSchema(nil,
+		NewLocalAttributeDef("a", LoadNoLock).WithAliases("b"),
+		NewLocalAttributeDef("b", LoadNoLock),
+	)).ValidateLocalAttributeForRegistry()
+	assert.Error(t, err)
+	_, err = NewPersistenceSchemaWithOptions(NewLocalAttributesSchema(nil,
+		NewLocalAttributeDef("a", LoadNoLock).WithVersion(1, nil),
+	)).ValidateLocalAttributeForRegistry()
+	assert.Error(t, err)
+}
@@ -0,0 +1,307 @@
+package xc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrLocalQueueBatchPublisherClosed is returned when publishing to a closed LocalQueueBatchPublisher
+var ErrLocalQueueBatchPublisherClosed = errors.New("LocalQueueBatchPublisher is closed")
+
+// LocalQueueBatchPublisher publishes the local queue messages asynchronously in the background.
+// The messages are buffered and grouped by processId, and published with Client.BatchPublishToLocalQueue
+// when a batch is full or has waited for the FlushInterval.
+// The messages of a process are published in order, one batch at a time.
+// See LocalQueueBatchPublisherOptions for the thresholds.
+type LocalQueueBatchPublisher interface {
+	// Publish buffers the message to publish to the process, and returns the future of the publishing.
+	// It blocks when the buffer is full, until there is room or the ctx is done
+	Publish(ctx context.Context, processId string, message LocalQueuePublishMessage) (LocalQueuePublishFuture, error)
+	// PublishWithCallback is the same as Publish, but invokes the callback with the result of the publishing.
+	// The callback is invoked in the background goroutine, so it should not block
+	PublishWithCallback(
+		ctx context.Context, processId string, message LocalQueuePublishMessage, callback func(err error),
+	) error
+	// Flush publishes all the buffered messages, and waits until they and the batches being published
+	// are published or the ctx is done
+	Flush(ctx context.Context) error
+	// Close stops accepting new messages, and flushes the buffered messages
+	Close(ctx context.Context) error
+}
+
+// LocalQueuePublishFuture is the result of a message published by LocalQueueBatchPublisher
+type LocalQueuePublishFuture interface {
+	// Done is closed when the message is published or failed
+	Done() <-chan struct{}
+	// Get waits until the message is published, and returns the error if failed.
+	// A batch fails or succeeds as a whole, so the error may be caused by another message in the same batch
+	Get(ctx context.Context) error
+}
+
+// NewLocalQueueBatchPublisher returns a LocalQueueBatchPublisher that publishes with the client
+func NewLocalQueueBatchPublisher(client Client, options *LocalQueueBatchPublisherOptions) LocalQueueBatchPublisher {
+	var opts LocalQueueBatchPublisherOptions
+	if options != nil {
+		opts = *options
+	}
+	opts = opts.withDefaults()
+	p := &localQueueBatchPublisherImpl{
+		client:        client,
+		options:       opts,
+		bufferSlots:   make(chan struct{}, opts.MaxBufferedMessages),
+		requestSlots:  make(chan struct{}, opts.MaxConcurrentRequests),
+		processQueues: map[string]*processPublishQueue{},
+		inFlight:      map[uint64]chan struct{}{},
+		stopCh:        make(chan struct{}),
+	}
+	p.tickerWg.Add(1)
+	go p.flushPeriodically()
+	return p
+}
+
+type localQueueBatchPublisherImpl struct {
+	client       Client
+	options      LocalQueueBatchPublisherOptions
+	bufferSlots  chan struct{}
+	requestSlots chan struct{}
+
+	sync.Mutex
+	processQueues map[string]*processPublishQueue
+	closed        bool
+	// dispatchedSeq is the sequence of the last dispatched batch, so that a Flush can snapshot it
+	// and wait only for the batches dispatched before the snapshot
+	dispatchedSeq uint64
+	// inFlight is the done channels of the batches handed to the client by the sequence,
+	// including the ones waiting for requestSlots
+	inFlight map[uint64]chan struct{}
+
+	stopCh   chan struct{}
+	tickerWg sync.WaitGroup
+}
+
+type processPublishQueue struct {
+	pending []*pendingLocalQueueMessage
+	// publishing is true when a batch of the process is being published
+	publishing bool
+	// flushRequested is true when Flush is called while a batch is being published,
+	// so that the rest are dispatched right after it instead of waiting for the FlushInterval
+	flushRequested bool
+}
+
+type pendingLocalQueueMessage struct {
+	message  LocalQueuePublishMessage
+	future   *localQueuePublishFutureImpl
+	callback func(err error)
+}
+
+func (p *localQueueBatchPublisherImpl) Publish(
+	ctx context.Context, processId string, message LocalQueuePublishMessage,
+) (LocalQueuePublishFuture, error) {
+	future := newLocalQueuePublishFuture()
+	if err := p.enqueue(ctx, processId, &pendingLocalQueueMessage{
+		message: message,
+		future:  future,
+	}); err != nil {
+		return nil, err
+	}
+	return future, nil
+}
+
+func (p *localQueueBatchPublisherImpl) PublishWithCallback(
+	ctx context.Context, processId string, message LocalQueuePublishMessage, callback func(err error),
+) error {
+	return p.enqueue(ctx, processId, &pendingLocalQueueMessage{
+		message:  message,
+		future:   newLocalQueuePublishFuture(),
+		callback: callback,
+	})
+}
+
+func (p *localQueueBatchPublisherImpl) enqueue(
+	ctx context.Context, processId string, msg *pendingLocalQueueMessage,
+) error {
+	if p.isClosed() {
+		return ErrLocalQueueBatchPublisherClosed
+	}
+	select {
+	case p.bufferSlots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if p.closed {
+		<-p.bufferSlots
+		return ErrLocalQueueBatchPublisherClosed
+	}
+	queue, ok := p.processQueues[processId]
+	if !ok {
+		queue = &processPublishQueue{}
+		p.processQueues[processId] = queue
+	}
+	queue.pending = append(queue.pending, msg)
+	if len(queue.pending) >= p.options.MaxBatchSize {
+		p.dispatchLocked(processId, queue)
+	}
+	return nil
+}
+
+func (p *localQueueBatchPublisherImpl) Flush(ctx context.Context) error {
+	p.Lock()
+	var waits []<-chan struct{}
+	for processId, queue := range p.processQueues {
+		for _, msg := range queue.pending {
+			waits = append(waits, msg.future.Done())
+		}
+		queue.flushRequested = true
+		p.dispatchLocked(processId, queue)
+	}
+	// also wait for the batches dispatched up to now, including the ones being published before the Flush,
+	// but not the batches of the messages published after it
+	generation := p.dispatchedSeq
+	for seq, done := range p.inFlight {
+		if seq <= generation {
+			waits = append(waits, done)
+		}
+	}
+	p.Unlock()
+
+	for _, done := range waits {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (p *localQueueBatchPublisherImpl) Close(ctx context.Context) error {
+	p.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.stopCh)
+	}
+	p.Unlock()
+
+	p.tickerWg.Wait()
+	return p.Flush(ctx)
+}
+
+func (p *localQueueBatchPublisherImpl) isClosed() bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.closed
+}
+
+func (p *localQueueBatchPublisherImpl) flushPeriodically() {
+	defer p.tickerWg.Done()
+	ticker := time.NewTicker(p.options.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.Lock()
+			for processId, queue := range p.processQueues {
+				p.dispatchLocked(processId, queue)
+			}
+			p.Unlock()
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// dispatchLocked starts publishing the next batch of the process, unless one is being published,
+// so that the messages of a process are published in order
+func (p *localQueueBatchPublisherImpl) dispatchLocked(processId string, queue *processPublishQueue) {
+	if queue.publishing {
+		return
+	}
+	if len(queue.pending) == 0 {
+		queue.flushRequested = false
+		delete(p.processQueues, processId)
+		return
+	}
+	batchSize := len(queue.pending)
+	if batchSize > p.options.MaxBatchSize {
+		batchSize = p.options.MaxBatchSize
+	}
+	batch := queue.pending[:batchSize]
+	queue.pending = append([]*pendingLocalQueueMessage(nil), queue.pending[batchSize:]...)
+	queue.publishing = true
+	p.dispatchedSeq++
+	seq := p.dispatchedSeq
+	p.inFlight[seq] = make(chan struct{})
+	go p.publishBatch(processId, queue, seq, batch)
+}
+
+func (p *localQueueBatchPublisherImpl) publishBatch(
+	processId string, queue *processPublishQueue, seq uint64, batch []*pendingLocalQueueMessage,
+) {
+	p.requestSlots <- struct{}{}
+	messages := make([]LocalQueuePublishMessage, 0, len(batch))
+	for _, msg := range batch {
+		messages = append(messages, msg.message)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.options.RequestTimeout)
+	err := p.client.BatchPublishToLocalQueue(ctx, processId, messages...)
+	cancel()
+	<-p.requestSlots
+
+	for _, msg := range batch {
+		msg.future.complete(err)
+		if msg.callback != nil {
+			msg.callback(err)
+		}
+		<-p.bufferSlots
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	close(p.inFlight[seq])
+	delete(p.inFlight, seq)
+	queue.publishing = false
+	if len(queue.pending) == 0 {
+		queue.flushRequested = false
+		if p.processQueues[processId] == queue {
+			delete(p.processQueues, processId)
+		}
+		return
+	}
+	if len(queue.pending) >= p.options.MaxBatchSize || queue.flushRequested {
+		p.dispatchLocked(processId, queue)
+	}
+}
+
+type localQueuePublishFutureImpl struct {
+	done chan struct{}
+	err  error
+}
+
+func newLocalQueuePublishFuture() *localQueuePublishFutureImpl {
+	return &localQueuePublishFutureImpl{
+		done: make(chan struct{}),
+	}
+}
+
+func (f *localQueuePublishFutureImpl) complete(err error) {
+	f.err = err
+	close(f.done)
+}
+
+func (f *localQueuePublishFutureImpl) Done() <-chan struct{} {
+	return f.done
+}
+
+func (f *localQueuePublishFutureImpl) Get(ctx context.Context) error {
+	select {
+	case <-f.done:
+		return f.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,51 @@
+package xc
+
+import "time"
+
+// LocalQueueBatchPublisherOptions controls how LocalQueueBatchPublisher buffers and flushes the messages
+type LocalQueueBatchPublisherOptions struct {
+	// MaxBatchSize is the max number of messages of a process to publish in one request,
+	// a batch is flushed immediately when it's full
+	// Default: DefaultLocalQueuePublishMaxBatchSize when set as 0
+	MaxBatchSize int
+	// FlushInterval is the max duration for a message to wait in the buffer before it's flushed
+	// Default: DefaultLocalQueuePublishFlushInterval when set as 0
+	FlushInterval time.Duration
+	// MaxBufferedMessages is the max number of messages that are buffered or being published,
+	// Publish blocks when it's reached, to bound the memory
+	// Default: DefaultLocalQueuePublishMaxBufferedMessages when set as 0
+	MaxBufferedMessages int
+	// MaxConcurrentRequests is the max number of concurrent publish requests to the server
+	// Default: DefaultLocalQueuePublishMaxConcurrentRequests when set as 0
+	MaxConcurrentRequests int
+	// RequestTimeout is the timeout of a publish request to the server
+	// Default: DefaultLocalQueuePublishRequestTimeout when set as 0
+	RequestTimeout time.Duration
+}
+
+const (
+	DefaultLocalQueuePublishMaxBatchSize          = 100
+	DefaultLocalQueuePublishFlushInterval         = 100 * time.Millisecond
+	DefaultLocalQueuePublishMaxBufferedMessages   = 10000
+	DefaultLocalQueuePublishMaxConcurrentRequests = 10
+	DefaultLocalQueuePublishRequestTimeout        = 10 * time.Second
+)
+
+func (o LocalQueueBatchPublisherOptions) withDefaults() LocalQueueBatchPublisherOptions {
+	if o.MaxBatchSize <= 0 {
+		o.MaxBatchSize = DefaultLocalQueuePublishMaxBatchSize
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = DefaultLocalQueuePublishFlushInterval
+	}
+	if o.MaxBufferedMessages <= 0 {
+		o.MaxBufferedMessages = DefaultLocalQueuePublishMaxBufferedMessages
+	}
+	if o.MaxConcurrentRequests <= 0 {
+		o.MaxConcurrentRequests = DefaultLocalQueuePublishMaxConcurrentRequests
+	}
+	if o.RequestTimeout <= 0 {
+		o.RequestTimeout = DefaultLocalQueuePublishRequestTimeout
+	}
+	return o
+}
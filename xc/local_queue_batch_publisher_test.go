@@ -0,0 +1,205 @@
+package xc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type batchPublishTestClient struct {
+	Client
+	sync.Mutex
+	batches     map[string][][]LocalQueuePublishMessage
+	failProcess string
+	blockCh     chan struct{}
+	// processBlockChs blocks the publishing of the processes, in addition to blockCh
+	processBlockChs map[string]chan struct{}
+}
+
+func newBatchPublishTestClient() *batchPublishTestClient {
+	return &batchPublishTestClient{
+		batches: map[string][][]LocalQueuePublishMessage{},
+	}
+}
+
+func (c *batchPublishTestClient) BatchPublishToLocalQueue(
+	ctx context.Context, processId string, messages ...LocalQueuePublishMessage,
+) error {
+	if c.blockCh != nil {
+		<-c.blockCh
+	}
+	if blockCh, ok := c.processBlockChs[processId]; ok {
+		<-blockCh
+	}
+	c.Lock()
+	defer c.Unlock()
+	if processId == c.failProcess {
+		return errors.New("failed to publish")
+	}
+	c.batches[processId] = append(c.batches[processId], messages)
+	return nil
+}
+
+func (c *batchPublishTestClient) getBatches(processId string) [][]LocalQueuePublishMessage {
+	c.Lock()
+	defer c.Unlock()
+	return c.batches[processId]
+}
+
+func TestLocalQueueBatchPublisherFlushesFullBatchesInOrder(t *testing.T) {
+	client := newBatchPublishTestClient()
+	publisher := NewLocalQueueBatchPublisher(client, &LocalQueueBatchPublisherOptions{
+		MaxBatchSize:  10,
+		FlushInterval: time.Hour,
+	})
+	ctx := context.Background()
+
+	var futures []LocalQueuePublishFuture
+	for i := 0; i < 25; i++ {
+		future, err := publisher.Publish(ctx, "process-1", LocalQueuePublishMessage{QueueName: "q1", Payload: i})
+		assert.Nil(t, err)
+		futures = append(futures, future)
+	}
+	for _, future := range futures[:20] {
+		assert.Nil(t, future.Get(ctx))
+	}
+	select {
+	case <-futures[20].Done():
+		assert.Fail(t, "the last batch should wait for the flush interval")
+	default:
+	}
+
+	assert.Nil(t, publisher.Close(ctx))
+	batches := client.getBatches("process-1")
+	assert.Equal(t, 3, len(batches))
+	var payloads []interface{}
+	for _, batch := range batches {
+		for _, msg := range batch {
+			payloads = append(payloads, msg.Payload)
+		}
+	}
+	for i := 0; i < 25; i++ {
+		assert.Equal(t, i, payloads[i])
+	}
+
+	_, err := publisher.Publish(ctx, "process-1", LocalQueuePublishMessage{QueueName: "q1"})
+	assert.Equal(t, ErrLocalQueueBatchPublisherClosed, err)
+}
+
+func TestLocalQueueBatchPublisherFlushesByIntervalAndGroupsByProcess(t *testing.T) {
+	client := newBatchPublishTestClient()
+	client.failProcess = "process-2"
+	publisher := NewLocalQueueBatchPublisher(client, &LocalQueueBatchPublisherOptions{
+		FlushInterval: 10 * time.Millisecond,
+	})
+	ctx := context.Background()
+
+	future1, err := publisher.Publish(ctx, "process-1", LocalQueuePublishMessage{QueueName: "q1"})
+	assert.Nil(t, err)
+	_, err = publisher.Publish(ctx, "process-1", LocalQueuePublishMessage{QueueName: "q2"})
+	assert.Nil(t, err)
+	callbackErrs := make(chan error, 1)
+	err = publisher.PublishWithCallback(ctx, "process-2", LocalQueuePublishMessage{QueueName: "q1"}, func(err error) {
+		callbackErrs <- err
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, future1.Get(ctx))
+	assert.NotNil(t, <-callbackErrs)
+	assert.Equal(t, [][]LocalQueuePublishMessage{{{QueueName: "q1"}, {QueueName: "q2"}}}, client.getBatches("process-1"))
+	assert.Nil(t, publisher.Close(ctx))
+}
+
+func TestLocalQueueBatchPublisherBoundsBufferedMessages(t *testing.T) {
+	client := newBatchPublishTestClient()
+	client.blockCh = make(chan struct{})
+	publisher := NewLocalQueueBatchPublisher(client, &LocalQueueBatchPublisherOptions{
+		MaxBatchSize:        2,
+		MaxBufferedMessages: 2,
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := publisher.Publish(ctx, "process-1", LocalQueuePublishMessage{QueueName: "q1"})
+		assert.Nil(t, err)
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err := publisher.Publish(timeoutCtx, "process-1", LocalQueuePublishMessage{QueueName: "q1"})
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	close(client.blockCh)
+	future, err := publisher.Publish(ctx, "process-1", LocalQueuePublishMessage{QueueName: "q1"})
+	assert.Nil(t, err)
+	assert.Nil(t, publisher.Flush(ctx))
+	assert.Nil(t, future.Get(ctx))
+	assert.Nil(t, publisher.Close(ctx))
+}
+
+func TestLocalQueueBatchPublisherCloseWaitsForInFlightBatches(t *testing.T) {
+	client := newBatchPublishTestClient()
+	client.blockCh = make(chan struct{})
+	publisher := NewLocalQueueBatchPublisher(client, &LocalQueueBatchPublisherOptions{
+		MaxBatchSize:  2,
+		FlushInterval: time.Hour,
+	})
+	ctx := context.Background()
+
+	// the full batch is handed to the client, nothing is buffered
+	for i := 0; i < 2; i++ {
+		_, err := publisher.Publish(ctx, "process-1", LocalQueuePublishMessage{QueueName: "q1"})
+		assert.Nil(t, err)
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, publisher.Flush(timeoutCtx))
+
+	close(client.blockCh)
+	assert.Nil(t, publisher.Close(ctx))
+	assert.Equal(t, 1, len(client.getBatches("process-1")))
+}
+
+func TestLocalQueueBatchPublisherFlushDoesNotWaitForLaterBatches(t *testing.T) {
+	client := newBatchPublishTestClient()
+	client.processBlockChs = map[string]chan struct{}{
+		"process-1": make(chan struct{}),
+		"process-2": make(chan struct{}),
+	}
+	publisher := NewLocalQueueBatchPublisher(client, &LocalQueueBatchPublisherOptions{
+		MaxBatchSize:  2,
+		FlushInterval: time.Hour,
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := publisher.Publish(ctx, "process-1", LocalQueuePublishMessage{QueueName: "q1"})
+		assert.Nil(t, err)
+	}
+	flushErrCh := make(chan error, 1)
+	go func() {
+		flushErrCh <- publisher.Flush(ctx)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// the batch of process-2 is dispatched after the Flush started, and is still being published
+	for i := 0; i < 2; i++ {
+		_, err := publisher.Publish(ctx, "process-2", LocalQueuePublishMessage{QueueName: "q1"})
+		assert.Nil(t, err)
+	}
+	close(client.processBlockChs["process-1"])
+	select {
+	case err := <-flushErrCh:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		assert.Fail(t, "Flush is waiting for the batch dispatched after it")
+	}
+	assert.Equal(t, 1, len(client.getBatches("process-1")))
+
+	close(client.processBlockChs["process-2"])
+	assert.Nil(t, publisher.Close(ctx))
+	assert.Equal(t, 1, len(client.getBatches("process-2")))
+}
@@ -1,5 +1,7 @@
 package xc
 
+import "github.com/google/uuid"
+
 type LocalQueuePublishOptions struct {
 	// DedupSeed is the seed to generate the DedupUUID
 	// by uuid.NewMD5(uuid.NameSpaceOID, []byte(*DedupSeed))
@@ -19,3 +21,8 @@ type LocalQueuePublishMessage struct {
 	// DedupUUID is the deduplication UUID
 	DedupUUID *string
 }
+
+// GetDedupUUIDFromSeed returns the DedupUUID generated from the DedupSeed
+func GetDedupUUIDFromSeed(dedupSeed string) string {
+	return uuid.NewMD5(uuid.NameSpaceOID, []byte(dedupSeed)).String()
+}
@@ -0,0 +1,39 @@
+package xc
+
+import (
+	"context"
+	"strings"
+)
+
+type namespaceContextKey struct{}
+
+// WithNamespace returns a copy of ctx that makes the Client/BasicClient call target the namespace,
+// instead of ClientOptions.Namespace
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceContextKey{}, namespace)
+}
+
+// GetNamespaceFromContext returns the namespace set by WithNamespace
+func GetNamespaceFromContext(ctx context.Context) (string, bool) {
+	namespace, ok := ctx.Value(namespaceContextKey{}).(string)
+	return namespace, ok && namespace != ""
+}
+
+// GetNamespacedApiPath returns the worker API path of a namespace served by WorkerServiceRouter
+// e.g. /my-namespace/api/v1/xcherry/worker/async-state/execute
+func GetNamespacedApiPath(namespace string, apiPath string) string {
+	return "/" + namespace + apiPath
+}
+
+// GetNamespacedWorkerUrl returns the worker url of a namespace served by WorkerServiceRouter,
+// which the server will send the worker API requests to
+func GetNamespacedWorkerUrl(workerUrl string, namespace string) string {
+	return strings.TrimSuffix(workerUrl, "/") + "/" + namespace
+}
+
+func getNamespace(ctx context.Context, defaultNamespace string) string {
+	if namespace, ok := GetNamespaceFromContext(ctx); ok {
+		return namespace
+	}
+	return defaultNamespace
+}
@@ -4,7 +4,10 @@ import "github.com/xcherryio/apis/goapi/xcapi"
 
 // Object is a representation of EncodedObject
 type Object interface {
+	// Get retrieves the actual object, panics on error
 	Get(resultPtr interface{})
+	// GetE retrieves the actual object, returns error instead of panic
+	GetE(resultPtr interface{}) error
 }
 
 type objectImpl struct {
@@ -24,8 +27,12 @@ func NewObject(EncodedObject *xcapi.EncodedObject, ObjectEncoder ObjectEncoder)
 // 1. capturing panic yourself
 // 2. get the error from WorkerService API, because WorkerService will use captureStateExecutionError to capture the error
 func (o objectImpl) Get(resultPtr interface{}) {
-	err := o.objectEncoder.Decode(o.encodedObject, resultPtr)
+	err := o.GetE(resultPtr)
 	if err != nil {
 		panic(err)
 	}
 }
+
+func (o objectImpl) GetE(resultPtr interface{}) error {
+	return o.objectEncoder.Decode(o.encodedObject, resultPtr)
+}
@@ -1,6 +1,8 @@
 package xc
 
 import (
+	"context"
+
 	"github.com/xcherryio/apis/goapi/xcapi"
 )
 
@@ -12,3 +14,42 @@ type ObjectEncoder interface {
 	// Decode deserialize an object
 	Decode(encodedObj *xcapi.EncodedObject, resultPtr interface{}) error
 }
+
+// encodeObject encodes the obj with the encoder, unless it's already encoded as *xcapi.EncodedObject
+func encodeObject(encoder ObjectEncoder, obj interface{}) (*xcapi.EncodedObject, error) {
+	if encoded, ok := obj.(*xcapi.EncodedObject); ok {
+		return encoded, nil
+	}
+	return encoder.Encode(obj)
+}
+
+// ProcessScopedObjectEncoder is an ObjectEncoder that encodes the objects of each process differently,
+// e.g. to offload the payloads under a key of the process so that they can be deleted after the process is closed.
+// The Client and the WorkerService encode the objects of a process with ForProcess(processId),
+// and call OnProcessClosing when they close the process, see GetObjectEncoderForProcess
+type ProcessScopedObjectEncoder interface {
+	ObjectEncoder
+	// ForProcess returns the ObjectEncoder for the objects of the process
+	ForProcess(processId string) ObjectEncoder
+	// OnProcessClosing is invoked by the WorkerService before returning a StateDecision that closes the process
+	// (except DEAD_END), and by the Client after stopping the process. An error fails the state API to retry.
+	// Note that it's not invoked when the process is closed by the timeout, see offload.MarkClosedProcesses
+	OnProcessClosing(ctx context.Context, processId string) error
+}
+
+// GetObjectEncoderForProcess returns the ObjectEncoder for the objects of the process,
+// which is the encoder itself unless it's a ProcessScopedObjectEncoder
+func GetObjectEncoderForProcess(encoder ObjectEncoder, processId string) ObjectEncoder {
+	if scoped, ok := encoder.(ProcessScopedObjectEncoder); ok {
+		return scoped.ForProcess(processId)
+	}
+	return encoder
+}
+
+// onProcessClosing invokes the ProcessScopedObjectEncoder.OnProcessClosing if the encoder is one
+func onProcessClosing(ctx context.Context, encoder ObjectEncoder, processId string) error {
+	if scoped, ok := encoder.(ProcessScopedObjectEncoder); ok {
+		return scoped.OnProcessClosing(ctx, processId)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package offload
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrBlobNotFound is returned by BlobStore.Get when the blob doesn't exist
+var ErrBlobNotFound = errors.New("blob is not found")
+
+// BlobStore stores the payloads offloaded by the ObjectEncoder.
+// Delete must be idempotent, deleting a blob that doesn't exist is not an error
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the blob, or an error that errors.Is ErrBlobNotFound if the blob doesn't exist
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// ListableBlobStore is the BlobStore that can list the blobs, which is required by CollectGarbage
+type ListableBlobStore interface {
+	BlobStore
+	// List returns the blobs of which the keys start with the prefix
+	List(ctx context.Context, prefix string) ([]BlobInfo, error)
+}
+
+type BlobInfo struct {
+	Key       string
+	Size      int64
+	CreatedAt time.Time
+}
@@ -0,0 +1,69 @@
+package offload
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NewMemoryBlobStore returns the ListableBlobStore in memory, e.g. for testing.
+// The blobs are only visible to the same process, so it can't be shared by the client and workers
+func NewMemoryBlobStore() ListableBlobStore {
+	return &memoryBlobStore{
+		blobs: map[string]memoryBlob{},
+	}
+}
+
+type memoryBlob struct {
+	data      []byte
+	createdAt time.Time
+}
+
+type memoryBlobStore struct {
+	lock  sync.RWMutex
+	blobs map[string]memoryBlob
+}
+
+func (s *memoryBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.blobs[key] = memoryBlob{
+		data:      append([]byte{}, data...),
+		createdAt: time.Now(),
+	}
+	return nil
+}
+
+func (s *memoryBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	blob, ok := s.blobs[key]
+	if !ok {
+		return nil, ErrBlobNotFound
+	}
+	return append([]byte{}, blob.data...), nil
+}
+
+func (s *memoryBlobStore) Delete(ctx context.Context, key string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.blobs, key)
+	return nil
+}
+
+func (s *memoryBlobStore) List(ctx context.Context, prefix string) ([]BlobInfo, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	var res []BlobInfo
+	for key, blob := range s.blobs {
+		if strings.HasPrefix(key, prefix) {
+			res = append(res, BlobInfo{
+				Key:       key,
+				Size:      int64(len(blob.data)),
+				CreatedAt: blob.createdAt,
+			})
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,53 @@
+package offload
+
+import (
+	"bytes"
+	"context"
+	"io"
+)
+
+// S3Client is the subset of an S3-compatible object storage API used by the BlobStore,
+// to be implemented by an adapter of the SDK of choice(e.g. AWS SDK or MinIO), so that this package has no dependency on them
+type S3Client interface {
+	PutObject(ctx context.Context, bucket string, key string, body io.Reader, size int64) error
+	// GetObject returns the body of the object, or an error that errors.Is ErrBlobNotFound if the object doesn't exist
+	GetObject(ctx context.Context, bucket string, key string) (io.ReadCloser, error)
+	// DeleteObject deletes the object, it returns nil if the object doesn't exist
+	DeleteObject(ctx context.Context, bucket string, key string) error
+	// ListObjects returns all the objects of which the keys start with the prefix
+	ListObjects(ctx context.Context, bucket string, prefix string) ([]BlobInfo, error)
+}
+
+// NewS3BlobStore returns the ListableBlobStore in the bucket of S3-compatible object storage
+func NewS3BlobStore(client S3Client, bucket string) ListableBlobStore {
+	return &s3BlobStore{
+		client: client,
+		bucket: bucket,
+	}
+}
+
+type s3BlobStore struct {
+	client S3Client
+	bucket string
+}
+
+func (s *s3BlobStore) Put(ctx context.Context, key string, data []byte) error {
+	return s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)))
+}
+
+func (s *s3BlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	body, err := s.client.GetObject(ctx, s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+func (s *s3BlobStore) Delete(ctx context.Context, key string) error {
+	return s.client.DeleteObject(ctx, s.bucket, key)
+}
+
+func (s *s3BlobStore) List(ctx context.Context, prefix string) ([]BlobInfo, error) {
+	return s.client.ListObjects(ctx, s.bucket, prefix)
+}
@@ -0,0 +1,187 @@
+package offload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+)
+
+// EncodingTypeBlobReference is the encoding of the EncodedObject of which the payload is offloaded to the BlobStore,
+// the data is the JSON of BlobReference
+const EncodingTypeBlobReference = "xcherryBlobReference"
+
+const (
+	DefaultThreshold = 64 * 1024
+	DefaultKeyPrefix = "xcherry/"
+	DefaultTimeout   = 10 * time.Second
+)
+
+type Options struct {
+	// Threshold is the size in bytes of the encoded data above which the data is offloaded to the BlobStore
+	// Default: DefaultThreshold when set as 0
+	Threshold int
+	// KeyPrefix is the prefix of the blob keys, e.g. for CollectGarbage to only list the offloaded payloads
+	// Default: DefaultKeyPrefix when set as empty string
+	KeyPrefix string
+	// Timeout is the timeout of each request to the BlobStore,
+	// because the ObjectEncoder interface doesn't take a context
+	// Default: DefaultTimeout when set as 0
+	Timeout time.Duration
+}
+
+// BlobReference is kept in the EncodedObject instead of the offloaded data
+type BlobReference struct {
+	Key string `json:"key"`
+	// Encoding is the encoding of the offloaded data by the wrapped ObjectEncoder
+	Encoding string `json:"encoding"`
+	Size     int    `json:"size"`
+}
+
+// ObjectEncoder wraps an xc.ObjectEncoder to offload the encoded data larger than the threshold to the BlobStore,
+// so that only a BlobReference is sent to xCherry server. The client and the workers must use the same BlobStore.
+// The blob keys are content-addressed under the process, KeyPrefix + processId + "/" + sha256 of the data,
+// so that retrying an Encode doesn't create another blob. The client and the WorkerService mark the process as closed
+// through xc.ProcessScopedObjectEncoder, and CollectGarbage deletes the blobs of the closed processes.
+// The processes closed by the timeout are marked by MarkClosedProcesses.
+type ObjectEncoder struct {
+	encoder xc.ObjectEncoder
+	store   BlobStore
+	options Options
+	// processId is the process of the objects, empty for the objects not scoped to a process
+	processId string
+}
+
+var _ xc.ProcessScopedObjectEncoder = (*ObjectEncoder)(nil)
+
+// closedMarkerName is the name of the blob under the key of a process that marks the process as closed,
+// which is not a sha256 in hex so that it never collides with the offloaded payloads
+const closedMarkerName = "closed"
+
+// NewObjectEncoder returns the ObjectEncoder to use by xc.ClientOptions.ObjectEncoder and xc.WorkerOptions.ObjectEncoder.
+// encoder is the wrapped ObjectEncoder, e.g. xc.GetDefaultObjectEncoder()
+func NewObjectEncoder(encoder xc.ObjectEncoder, store BlobStore, options *Options) *ObjectEncoder {
+	var opts Options
+	if options != nil {
+		opts = *options
+	}
+	if opts.Threshold == 0 {
+		opts.Threshold = DefaultThreshold
+	}
+	if opts.KeyPrefix == "" {
+		opts.KeyPrefix = DefaultKeyPrefix
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	return &ObjectEncoder{
+		encoder: encoder,
+		store:   store,
+		options: opts,
+	}
+}
+
+// ForProcess returns the ObjectEncoder that offloads the payloads under the key of the process
+func (e *ObjectEncoder) ForProcess(processId string) xc.ObjectEncoder {
+	scoped := *e
+	scoped.processId = processId
+	return &scoped
+}
+
+// OnProcessClosing marks the process as closed, so that CollectGarbage deletes its blobs after the retention
+func (e *ObjectEncoder) OnProcessClosing(ctx context.Context, processId string) error {
+	if processId == "" {
+		return nil
+	}
+	return markProcessClosed(ctx, e.store, e.options.KeyPrefix, processId)
+}
+
+func markProcessClosed(ctx context.Context, store BlobStore, keyPrefix, processId string) error {
+	return store.Put(ctx, getProcessKeyPrefix(keyPrefix, processId)+closedMarkerName, nil)
+}
+
+func (e *ObjectEncoder) GetEncodingType() string {
+	return e.encoder.GetEncodingType()
+}
+
+func (e *ObjectEncoder) Encode(obj interface{}) (*xcapi.EncodedObject, error) {
+	encoded, err := e.encoder.Encode(obj)
+	if err != nil || len(encoded.GetData()) <= e.options.Threshold {
+		return encoded, err
+	}
+
+	ref := BlobReference{
+		Key:      e.getKey(encoded),
+		Encoding: encoded.GetEncoding(),
+		Size:     len(encoded.GetData()),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), e.options.Timeout)
+	defer cancel()
+	// always put even if the blob exists, which refreshes the creation time for CollectGarbage
+	if err := e.store.Put(ctx, ref.Key, []byte(encoded.GetData())); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(ref)
+	if err != nil {
+		return nil, err
+	}
+	return &xcapi.EncodedObject{
+		Encoding: EncodingTypeBlobReference,
+		Data:     string(data),
+	}, nil
+}
+
+func (e *ObjectEncoder) Decode(encodedObj *xcapi.EncodedObject, resultPtr interface{}) error {
+	ref, ok, err := GetBlobReference(encodedObj)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return e.encoder.Decode(encodedObj, resultPtr)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.options.Timeout)
+	defer cancel()
+	data, err := e.store.Get(ctx, ref.Key)
+	if err != nil {
+		return err
+	}
+	return e.encoder.Decode(&xcapi.EncodedObject{
+		Encoding: ref.Encoding,
+		Data:     string(data),
+	}, resultPtr)
+}
+
+// getKey returns the content-addressed key of the encoded data
+func (e *ObjectEncoder) getKey(encoded *xcapi.EncodedObject) string {
+	hash := sha256.New()
+	hash.Write([]byte(encoded.GetEncoding()))
+	hash.Write([]byte{0})
+	hash.Write([]byte(encoded.GetData()))
+	prefix := e.options.KeyPrefix
+	if e.processId != "" {
+		prefix = getProcessKeyPrefix(prefix, e.processId)
+	}
+	return prefix + hex.EncodeToString(hash.Sum(nil))
+}
+
+func getProcessKeyPrefix(keyPrefix, processId string) string {
+	return keyPrefix + url.PathEscape(processId) + "/"
+}
+
+// GetBlobReference returns the BlobReference if the encoded object is offloaded
+func GetBlobReference(encodedObj *xcapi.EncodedObject) (*BlobReference, bool, error) {
+	if encodedObj == nil || encodedObj.GetEncoding() != EncodingTypeBlobReference {
+		return nil, false, nil
+	}
+	ref := &BlobReference{}
+	if err := json.Unmarshal([]byte(encodedObj.GetData()), ref); err != nil {
+		return nil, false, err
+	}
+	return ref, true, nil
+}
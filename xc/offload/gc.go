@@ -0,0 +1,130 @@
+package offload
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+)
+
+// CollectGarbage deletes the blobs with the key prefix that are no longer referenced, and returns the number of
+// deleted blobs:
+//   - the blobs of a process that has been marked as closed before the retention, which are created before the
+//     marker. The retention must be longer than the other threads of the process run after the close decision,
+//     e.g. GRACEFUL_COMPLETE_PROCESS. The blobs of a new execution of the same processId are kept.
+//   - the blobs not scoped to a process(e.g. encoded without xc.GetObjectEncoderForProcess), which are created
+//     before the retention.
+//
+// The processes closed by the timeout are not marked as closed by the client or the WorkerService,
+// call MarkClosedProcesses before to mark them
+func CollectGarbage(
+	ctx context.Context, store ListableBlobStore, keyPrefix string, retention time.Duration,
+) (int, error) {
+	blobs, err := store.List(ctx, keyPrefix)
+	if err != nil {
+		return 0, err
+	}
+	deadline := time.Now().Add(-retention)
+
+	closedAt := map[string]time.Time{}
+	for _, blob := range blobs {
+		processKey, name, ok := splitProcessKey(keyPrefix, blob.Key)
+		if ok && name == closedMarkerName && blob.CreatedAt.Before(deadline) {
+			closedAt[processKey] = blob.CreatedAt
+		}
+	}
+
+	deleted := 0
+	for _, blob := range blobs {
+		processKey, name, ok := splitProcessKey(keyPrefix, blob.Key)
+		if !ok {
+			if !blob.CreatedAt.Before(deadline) {
+				continue
+			}
+		} else {
+			closed, isClosed := closedAt[processKey]
+			if !isClosed || name == closedMarkerName || blob.CreatedAt.After(closed) {
+				continue
+			}
+		}
+		if err := store.Delete(ctx, blob.Key); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	// the markers are deleted last, so that the blobs are deleted by the next run if this one fails
+	for processKey := range closedAt {
+		if err := store.Delete(ctx, keyPrefix+processKey+"/"+closedMarkerName); err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// MarkClosedProcesses marks the processes of the blobs with the key prefix as closed if they are no longer running
+// on the server, e.g. closed by the timeout, which is seen by neither the client nor the WorkerService.
+// Only the processes without a marker, of which all the blobs are created before the retention, are described
+// with the client, which must be of the namespace of the processes. Returns the number of the marked processes
+func MarkClosedProcesses(
+	ctx context.Context, client xc.Client, store ListableBlobStore, keyPrefix string, retention time.Duration,
+) (int, error) {
+	blobs, err := store.List(ctx, keyPrefix)
+	if err != nil {
+		return 0, err
+	}
+	deadline := time.Now().Add(-retention)
+
+	var processKeys []string
+	inactive := map[string]bool{}
+	for _, blob := range blobs {
+		processKey, name, ok := splitProcessKey(keyPrefix, blob.Key)
+		if !ok {
+			continue
+		}
+		if _, seen := inactive[processKey]; !seen {
+			processKeys = append(processKeys, processKey)
+			inactive[processKey] = true
+		}
+		if name == closedMarkerName || !blob.CreatedAt.Before(deadline) {
+			inactive[processKey] = false
+		}
+	}
+
+	marked := 0
+	for _, processKey := range processKeys {
+		if !inactive[processKey] {
+			continue
+		}
+		processId, err := url.PathUnescape(processKey)
+		if err != nil {
+			return marked, err
+		}
+		resp, err := client.DescribeCurrentProcessExecution(ctx, processId)
+		if err != nil && !xc.IsProcessNotExistsError(err) {
+			return marked, err
+		}
+		if err == nil && (resp.Status == nil || *resp.Status == xcapi.RUNNING) {
+			continue
+		}
+		if err := markProcessClosed(ctx, store, keyPrefix, processId); err != nil {
+			return marked, err
+		}
+		marked++
+	}
+	return marked, nil
+}
+
+// splitProcessKey returns the escaped processId and the name of the blob,
+// or false if the blob is not scoped to a process
+func splitProcessKey(keyPrefix, key string) (string, string, bool) {
+	rest := strings.TrimPrefix(key, keyPrefix)
+	i := strings.LastIndex(rest, "/")
+	if i < 0 {
+		return "", "", false
+	}
+	return rest[:i], rest[i+1:], true
+}
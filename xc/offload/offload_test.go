@@ -0,0 +1,382 @@
+package offload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+)
+
+type document struct {
+	Name    string
+	Content string
+}
+
+// fakeS3Client is an S3Client of a single in-memory bucket
+type fakeS3Client struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func (c *fakeS3Client) PutObject(ctx context.Context, bucket string, key string, body io.Reader, size int64) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.objects[bucket+"/"+key] = data
+	return nil
+}
+
+func (c *fakeS3Client) GetObject(ctx context.Context, bucket string, key string) (io.ReadCloser, error) {
+	c.Lock()
+	defer c.Unlock()
+	data, ok := c.objects[bucket+"/"+key]
+	if !ok {
+		return nil, ErrBlobNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (c *fakeS3Client) DeleteObject(ctx context.Context, bucket string, key string) error {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.objects, bucket+"/"+key)
+	return nil
+}
+
+func (c *fakeS3Client) ListObjects(ctx context.Context, bucket string, prefix string) ([]BlobInfo, error) {
+	c.Lock()
+	defer c.Unlock()
+	var res []BlobInfo
+	for key, data := range c.objects {
+		if strings.HasPrefix(key, bucket+"/"+prefix) {
+			res = append(res, BlobInfo{Key: strings.TrimPrefix(key, bucket+"/"), Size: int64(len(data))})
+		}
+	}
+	return res, nil
+}
+
+func getTestBlobStores(t *testing.T) map[string]ListableBlobStore {
+	fileStore, err := NewFileBlobStore(t.TempDir())
+	assert.Nil(t, err)
+	return map[string]ListableBlobStore{
+		"memory": NewMemoryBlobStore(),
+		"file":   fileStore,
+		"s3":     NewS3BlobStore(&fakeS3Client{objects: map[string][]byte{}}, "bucket"),
+	}
+}
+
+func TestBlobStores(t *testing.T) {
+	ctx := context.Background()
+	for name, store := range getTestBlobStores(t) {
+		t.Run(name, func(t *testing.T) {
+			assert.Nil(t, store.Put(ctx, "prefix/a", []byte("data-a")))
+			assert.Nil(t, store.Put(ctx, "prefix/b", []byte("data-b")))
+			assert.Nil(t, store.Put(ctx, "other/c", []byte("data-c")))
+
+			data, err := store.Get(ctx, "prefix/a")
+			assert.Nil(t, err)
+			assert.Equal(t, "data-a", string(data))
+
+			blobs, err := store.List(ctx, "prefix/")
+			assert.Nil(t, err)
+			assert.Equal(t, 2, len(blobs))
+
+			assert.Nil(t, store.Delete(ctx, "prefix/a"))
+			assert.Nil(t, store.Delete(ctx, "prefix/a"))
+			_, err = store.Get(ctx, "prefix/a")
+			assert.True(t, errors.Is(err, ErrBlobNotFound))
+		})
+	}
+
+	fileStore, err := NewFileBlobStore(t.TempDir())
+	assert.Nil(t, err)
+	assert.Error(t, fileStore.Put(ctx, "../escape", []byte("data")))
+}
+
+func TestObjectEncoderOffloadsLargePayloads(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryBlobStore()
+	encoder := NewObjectEncoder(xc.GetDefaultObjectEncoder(), store, &Options{
+		Threshold: 100,
+	})
+	assert.Equal(t, xc.GetDefaultObjectEncoder().GetEncodingType(), encoder.GetEncodingType())
+
+	small, err := encoder.Encode(document{Name: "small"})
+	assert.Nil(t, err)
+	assert.Equal(t, "golangJson", small.GetEncoding())
+
+	largeDoc := document{Name: "large", Content: strings.Repeat("x", 1000)}
+	large, err := encoder.Encode(largeDoc)
+	assert.Nil(t, err)
+	assert.Equal(t, EncodingTypeBlobReference, large.GetEncoding())
+	assert.Less(t, len(large.GetData()), 200)
+	ref, ok, err := GetBlobReference(large)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.True(t, strings.HasPrefix(ref.Key, DefaultKeyPrefix))
+	assert.Equal(t, "golangJson", ref.Encoding)
+
+	var decoded document
+	assert.Nil(t, encoder.Decode(large, &decoded))
+	assert.Equal(t, largeDoc, decoded)
+	assert.Nil(t, encoder.Decode(small, &decoded))
+	assert.Equal(t, document{Name: "small"}, decoded)
+
+	// the object decoded by the SDK, e.g. the state input
+	xc.NewObject(large, encoder).Get(&decoded)
+	assert.Equal(t, largeDoc, decoded)
+
+	// the keys are content-addressed, so that encoding again doesn't create another blob
+	again, err := encoder.Encode(largeDoc)
+	assert.Nil(t, err)
+	assert.Equal(t, large, again)
+	blobs, err := store.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blobs))
+
+	scoped, err := encoder.ForProcess("order/1").Encode(largeDoc)
+	assert.Nil(t, err)
+	ref, _, err = GetBlobReference(scoped)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(ref.Key, DefaultKeyPrefix+"order%2F1/"))
+	assert.Nil(t, encoder.Decode(scoped, &decoded))
+	assert.Equal(t, largeDoc, decoded)
+
+	assert.Nil(t, store.Delete(ctx, ref.Key))
+	assert.True(t, errors.Is(encoder.Decode(scoped, &decoded), ErrBlobNotFound))
+}
+
+type offloadTestProcess struct {
+	xc.ProcessDefaults
+}
+
+func (p offloadTestProcess) GetAsyncStateSchema() xc.StateSchema {
+	return xc.NewStateSchema(&offloadTestState{})
+}
+
+type offloadTestState struct {
+	xc.AsyncStateDefaultsSkipWaitUntil
+}
+
+func (s *offloadTestState) Execute(
+	ctx xc.Context, input xc.Object, commandResults xc.CommandResults, persistence xc.Persistence,
+	communication xc.Communication,
+) (*xc.StateDecision, error) {
+	var doc document
+	input.Get(&doc)
+	if doc.Name == "dead-end" {
+		return xc.DeadEnd, nil
+	}
+	return xc.CompleteProcessWithResult(document{Name: "result", Content: doc.Content}), nil
+}
+
+func TestWorkerMarksProcessClosedForCollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryBlobStore()
+	encoder := NewObjectEncoder(xc.GetDefaultObjectEncoder(), store, &Options{
+		Threshold: 10,
+	})
+	registry := xc.NewRegistry()
+	assert.Nil(t, registry.AddProcess(offloadTestProcess{}))
+	options := xc.GetDefaultWorkerOptions()
+	options.ObjectEncoder = encoder
+	workerService := xc.NewWorkerService(registry, &options)
+
+	execute := func(processId string, input document) *xcapi.AsyncStateExecuteResponse {
+		encodedInput, err := xc.GetObjectEncoderForProcess(encoder, processId).Encode(input)
+		assert.Nil(t, err)
+		resp, err := workerService.HandleAsyncStateExecute(ctx, xcapi.AsyncStateExecuteRequest{
+			Context:     xcapi.Context{ProcessId: processId},
+			ProcessType: xc.GetFinalProcessType(offloadTestProcess{}),
+			StateId:     xc.GetFinalStateId(&offloadTestState{}),
+			StateInput:  encodedInput,
+		})
+		assert.Nil(t, err)
+		return resp
+	}
+	countBlobs := func(prefix string) int {
+		blobs, err := store.List(ctx, prefix)
+		assert.Nil(t, err)
+		return len(blobs)
+	}
+
+	resp := execute("order-1", document{Content: strings.Repeat("x", 100)})
+	var result document
+	assert.Nil(t, encoder.Decode(resp.StateDecision.ThreadCloseDecision.CloseInput, &result))
+	assert.Equal(t, "result", result.Name)
+	// the input, the result and the closed marker
+	assert.Equal(t, 3, countBlobs(DefaultKeyPrefix+"order-1/"))
+
+	// DEAD_END doesn't close the process
+	execute("order-2", document{Name: "dead-end", Content: strings.Repeat("x", 100)})
+	assert.Equal(t, 1, countBlobs(DefaultKeyPrefix+"order-2/"))
+	// the blobs not scoped to a process are collected by age
+	_, err := encoder.Encode(document{Content: strings.Repeat("y", 100)})
+	assert.Nil(t, err)
+
+	deleted, err := CollectGarbage(ctx, store, DefaultKeyPrefix, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, deleted)
+
+	time.Sleep(time.Millisecond * 10)
+	// a new execution of the same processId after the close is kept
+	_, err = encoder.ForProcess("order-1").Encode(document{Content: strings.Repeat("z", 100)})
+	assert.Nil(t, err)
+	deleted, err = CollectGarbage(ctx, store, DefaultKeyPrefix, time.Millisecond*5)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, deleted)
+	assert.Equal(t, 1, countBlobs(DefaultKeyPrefix+"order-1/"))
+	assert.Equal(t, 1, countBlobs(DefaultKeyPrefix+"order-2/"))
+	assert.Equal(t, 2, countBlobs(DefaultKeyPrefix))
+}
+
+type offloadLocalAttributeTestProcess struct {
+	xc.ProcessDefaults
+}
+
+func (p offloadLocalAttributeTestProcess) GetAsyncStateSchema() xc.StateSchema {
+	return xc.NewStateSchema(&offloadLocalAttributeTestState{})
+}
+
+func (p offloadLocalAttributeTestProcess) GetPersistenceSchema() xc.PersistenceSchema {
+	return xc.NewPersistenceSchemaWithOptions(
+		xc.NewLocalAttributesSchema(
+			xcapi.NO_LOCKING.Ptr(),
+			xc.NewLocalAttributeDef("doc", xc.LoadNoLock),
+		),
+	)
+}
+
+type offloadLocalAttributeTestState struct {
+	xc.AsyncStateDefaultsSkipWaitUntil
+}
+
+func (s *offloadLocalAttributeTestState) Execute(
+	ctx xc.Context, input xc.Object, commandResults xc.CommandResults, persistence xc.Persistence,
+	communication xc.Communication,
+) (*xc.StateDecision, error) {
+	var doc, prev document
+	input.Get(&doc)
+	persistence.GetLocalAttribute("doc", &prev)
+	persistence.SetLocalAttribute("doc", document{Name: doc.Name, Content: prev.Content + doc.Content})
+	return xc.DeadEnd, nil
+}
+
+func TestWorkerOffloadsLocalAttributes(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryBlobStore()
+	encoder := NewObjectEncoder(xc.GetDefaultObjectEncoder(), store, &Options{
+		Threshold: 10,
+	})
+	registry := xc.NewRegistry()
+	assert.Nil(t, registry.AddProcess(offloadLocalAttributeTestProcess{}))
+	options := xc.GetDefaultWorkerOptions()
+	options.ObjectEncoder = encoder
+	workerService := xc.NewWorkerService(registry, &options)
+
+	execute := func(content string, loaded []xcapi.KeyValue) []xcapi.KeyValue {
+		encodedInput, err := xc.GetDefaultObjectEncoder().Encode(document{Name: "doc", Content: content})
+		assert.Nil(t, err)
+		resp, err := workerService.HandleAsyncStateExecute(ctx, xcapi.AsyncStateExecuteRequest{
+			Context:               xcapi.Context{ProcessId: "order-1"},
+			ProcessType:           xc.GetFinalProcessType(offloadLocalAttributeTestProcess{}),
+			StateId:               xc.GetFinalStateId(&offloadLocalAttributeTestState{}),
+			StateInput:            encodedInput,
+			LoadedLocalAttributes: &xcapi.LoadLocalAttributesResponse{Attributes: loaded},
+		})
+		assert.Nil(t, err)
+		return resp.WriteToLocalAttributes
+	}
+
+	written := execute(strings.Repeat("x", 100), nil)
+	assert.Equal(t, 1, len(written))
+	ref, ok, err := GetBlobReference(&written[0].Value)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.True(t, strings.HasPrefix(ref.Key, DefaultKeyPrefix+"order-1/"))
+
+	// the offloaded value is loaded in the next state
+	written = execute(strings.Repeat("y", 100), written)
+	var doc document
+	assert.Nil(t, encoder.Decode(&written[0].Value, &doc))
+	assert.Equal(t, strings.Repeat("x", 100)+strings.Repeat("y", 100), doc.Content)
+
+	// the values written by the default encoder are still loaded
+	old, err := xc.GetDefaultObjectEncoder().Encode(document{Content: "z"})
+	assert.Nil(t, err)
+	written = execute("", []xcapi.KeyValue{{Key: "doc", Value: *old}})
+	assert.Nil(t, encoder.Decode(&written[0].Value, &doc))
+	assert.Equal(t, "z", doc.Content)
+}
+
+type describeTestClient struct {
+	xc.Client
+	statuses map[string]xcapi.ProcessStatus
+}
+
+func (c *describeTestClient) DescribeCurrentProcessExecution(
+	ctx context.Context, processId string,
+) (*xcapi.ProcessExecutionDescribeResponse, error) {
+	status, ok := c.statuses[processId]
+	if !ok {
+		err := xc.NewApiError(errors.New("not exists"), nil, nil, &xcapi.ApiErrorResponse{}).(*xc.ApiError)
+		err.StatusCode = http.StatusNotFound
+		return nil, err
+	}
+	return &xcapi.ProcessExecutionDescribeResponse{
+		Status: status.Ptr(),
+	}, nil
+}
+
+func TestMarkClosedProcessesForCollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryBlobStore()
+	encoder := NewObjectEncoder(xc.GetDefaultObjectEncoder(), store, &Options{
+		Threshold: 10,
+	})
+	client := &describeTestClient{
+		statuses: map[string]xcapi.ProcessStatus{
+			"timeout/1": xcapi.TIMEOUT,
+			"running":   xcapi.RUNNING,
+		},
+	}
+	for _, processId := range []string{"timeout/1", "running", "not-exists"} {
+		_, err := encoder.ForProcess(processId).Encode(document{Content: strings.Repeat("x", 100)})
+		assert.Nil(t, err)
+	}
+
+	// the blobs are not older than the retention yet
+	marked, err := MarkClosedProcesses(ctx, client, store, DefaultKeyPrefix, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, marked)
+
+	time.Sleep(time.Millisecond * 10)
+	marked, err = MarkClosedProcesses(ctx, client, store, DefaultKeyPrefix, time.Millisecond*5)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, marked)
+	// the marked processes are not described again
+	marked, err = MarkClosedProcesses(ctx, client, store, DefaultKeyPrefix, time.Millisecond*5)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, marked)
+
+	time.Sleep(time.Millisecond * 10)
+	deleted, err := CollectGarbage(ctx, store, DefaultKeyPrefix, time.Millisecond*5)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, deleted)
+	blobs, err := store.List(ctx, DefaultKeyPrefix)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blobs))
+	assert.True(t, strings.HasPrefix(blobs[0].Key, DefaultKeyPrefix+"running/"))
+}
@@ -0,0 +1,58 @@
+package outbox
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Dialect is the SQL differences of the databases that the outbox table is in
+type Dialect struct {
+	// Placeholder returns the n-th(starting from 1) bind parameter of a statement
+	Placeholder func(n int) string
+	// IdColumnDefinition is the definition of the auto increment primary key column "id"
+	IdColumnDefinition string
+}
+
+var (
+	SQLiteDialect = Dialect{
+		Placeholder:        questionPlaceholder,
+		IdColumnDefinition: "id INTEGER PRIMARY KEY AUTOINCREMENT",
+	}
+	MySQLDialect = Dialect{
+		Placeholder:        questionPlaceholder,
+		IdColumnDefinition: "id BIGINT AUTO_INCREMENT PRIMARY KEY",
+	}
+	PostgresDialect = Dialect{
+		Placeholder: func(n int) string {
+			return "$" + strconv.Itoa(n)
+		},
+		IdColumnDefinition: "id BIGSERIAL PRIMARY KEY",
+	}
+)
+
+func questionPlaceholder(int) string {
+	return "?"
+}
+
+func (d Dialect) getCreateTableStatement(tableName string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %v (
+	%v,
+	request_type VARCHAR(32) NOT NULL,
+	namespace VARCHAR(255) NOT NULL,
+	process_id VARCHAR(255) NOT NULL,
+	request TEXT NOT NULL,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	last_error TEXT
+)`, tableName, d.IdColumnDefinition)
+}
+
+func (d Dialect) getPlaceholders(count int) string {
+	placeholders := ""
+	for i := 1; i <= count; i++ {
+		if i > 1 {
+			placeholders += ", "
+		}
+		placeholders += d.Placeholder(i)
+	}
+	return placeholders
+}
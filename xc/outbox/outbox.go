@@ -0,0 +1,184 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+	"github.com/xcherryio/sdk-go/xc/ptr"
+)
+
+// SQLExecutor executes the statements, e.g. *sql.Tx of the caller's transaction
+type SQLExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+type Options struct {
+	// TableName is the name of the outbox table
+	// Default: DefaultTableName when set as empty string
+	TableName string
+	// Dialect is the SQL dialect of the database
+	// Default: SQLiteDialect when Placeholder is nil
+	Dialect Dialect
+	// ObjectEncoder encodes the payloads and inputs when they are written into the table
+	// Default: xc.GetDefaultObjectEncoder() when set as nil
+	ObjectEncoder xc.ObjectEncoder
+	// Namespace is the namespace of the requests, which can be overridden per call by xc.WithNamespace
+	// Default: xc.DefaultNamespace when set as empty string
+	Namespace string
+}
+
+const DefaultTableName = "xcherry_outbox"
+
+const (
+	requestTypePublishToLocalQueue = "PUBLISH_TO_LOCAL_QUEUE"
+	requestTypeStartProcess        = "START_PROCESS"
+)
+
+// Outbox writes the requests to xCherry server into a table inside the caller's transaction,
+// so that they are sent exactly when the transaction commits. See Relay for sending them.
+type Outbox struct {
+	options Options
+}
+
+func NewOutbox(options *Options) *Outbox {
+	var opts Options
+	if options != nil {
+		opts = *options
+	}
+	if opts.TableName == "" {
+		opts.TableName = DefaultTableName
+	}
+	if opts.Dialect.Placeholder == nil {
+		opts.Dialect = SQLiteDialect
+	}
+	if opts.ObjectEncoder == nil {
+		opts.ObjectEncoder = xc.GetDefaultObjectEncoder()
+	}
+	if opts.Namespace == "" {
+		opts.Namespace = xc.DefaultNamespace
+	}
+	return &Outbox{
+		options: opts,
+	}
+}
+
+// CreateTable creates the outbox table if not exists
+func (o *Outbox) CreateTable(ctx context.Context, db SQLExecutor) error {
+	_, err := db.ExecContext(ctx, o.options.Dialect.getCreateTableStatement(o.options.TableName))
+	return err
+}
+
+// PublishToLocalQueue writes a message to publish to a local queue of the process
+// When DedupSeed and DedupUUID are both nil, a random DedupUUID is generated and stored in the outbox row,
+// so that relaying the message more than once is deduplicated by the server
+func (o *Outbox) PublishToLocalQueue(
+	ctx context.Context, tx SQLExecutor, processId string, queueName string, payload interface{},
+	options *xc.LocalQueuePublishOptions,
+) error {
+	message := xc.LocalQueuePublishMessage{
+		QueueName: queueName,
+		Payload:   payload,
+	}
+	if options != nil {
+		message.DedupSeed = options.DedupSeed
+		message.DedupUUID = options.DedupUUID
+	}
+	return o.BatchPublishToLocalQueue(ctx, tx, processId, message)
+}
+
+// BatchPublishToLocalQueue writes messages to publish to local queues of the process, one row per message
+func (o *Outbox) BatchPublishToLocalQueue(
+	ctx context.Context, tx SQLExecutor, processId string, messages ...xc.LocalQueuePublishMessage,
+) error {
+	for _, message := range messages {
+		req := publishToLocalQueueRequest{
+			QueueName: message.QueueName,
+			DedupSeed: message.DedupSeed,
+			DedupUUID: message.DedupUUID,
+		}
+		if req.DedupSeed == nil && req.DedupUUID == nil {
+			req.DedupUUID = ptr.Any(uuid.NewString())
+		}
+		if message.Payload != nil {
+			payload, err := xc.GetObjectEncoderForProcess(o.options.ObjectEncoder, processId).Encode(message.Payload)
+			if err != nil {
+				return err
+			}
+			req.Payload = payload
+		}
+		if err := o.insert(ctx, tx, requestTypePublishToLocalQueue, processId, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StartProcess writes a request to start a process execution
+// The relay treats xc.IsProcessAlreadyStartedError as success, so that relaying it more than once is idempotent
+// as long as the ProcessIdReusePolicy doesn't allow starting the processId again
+func (o *Outbox) StartProcess(
+	ctx context.Context, tx SQLExecutor, definition xc.Process, processId string, input interface{},
+	options *xc.ProcessStartOptions,
+) error {
+	req := startProcessRequest{
+		ProcessType: xc.GetFinalProcessType(definition),
+	}
+	if input != nil {
+		encodedInput, err := xc.GetObjectEncoderForProcess(o.options.ObjectEncoder, processId).Encode(input)
+		if err != nil {
+			return err
+		}
+		req.Input = encodedInput
+	}
+	if options != nil {
+		req.TimeoutSeconds = options.TimeoutSeconds
+		req.IdReusePolicy = options.IdReusePolicy
+		if len(options.InitialLocalAttribute) > 0 {
+			req.InitialLocalAttribute = map[string]*xcapi.EncodedObject{}
+			for key, attr := range options.InitialLocalAttribute {
+				encodedAttr, err := xc.GetObjectEncoderForProcess(o.options.ObjectEncoder, processId).Encode(attr)
+				if err != nil {
+					return err
+				}
+				req.InitialLocalAttribute[key] = encodedAttr
+			}
+		}
+	}
+	return o.insert(ctx, tx, requestTypeStartProcess, processId, req)
+}
+
+func (o *Outbox) insert(
+	ctx context.Context, tx SQLExecutor, requestType string, processId string, request interface{},
+) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	namespace := o.options.Namespace
+	if ns, ok := xc.GetNamespaceFromContext(ctx); ok {
+		namespace = ns
+	}
+	query := "INSERT INTO " + o.options.TableName + " (request_type, namespace, process_id, request) VALUES (" +
+		o.options.Dialect.getPlaceholders(4) + ")"
+	_, err = tx.ExecContext(ctx, query, requestType, namespace, processId, string(data))
+	return err
+}
+
+type publishToLocalQueueRequest struct {
+	QueueName string               `json:"queueName"`
+	Payload   *xcapi.EncodedObject `json:"payload,omitempty"`
+	DedupSeed *string              `json:"dedupSeed,omitempty"`
+	DedupUUID *string              `json:"dedupUUID,omitempty"`
+}
+
+type startProcessRequest struct {
+	ProcessType           string                          `json:"processType"`
+	Input                 *xcapi.EncodedObject            `json:"input,omitempty"`
+	TimeoutSeconds        *int32                          `json:"timeoutSeconds,omitempty"`
+	IdReusePolicy         *xcapi.ProcessIdReusePolicy     `json:"idReusePolicy,omitempty"`
+	InitialLocalAttribute map[string]*xcapi.EncodedObject `json:"initialLocalAttribute,omitempty"`
+}
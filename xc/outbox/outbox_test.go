@@ -0,0 +1,259 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+)
+
+type publishedBatch struct {
+	namespace string
+	processId string
+	messages  []xc.LocalQueuePublishMessage
+}
+
+type startedProcess struct {
+	processType string
+	processId   string
+	input       interface{}
+	options     *xc.ProcessStartOptions
+}
+
+type outboxTestClient struct {
+	xc.Client
+	published     []publishedBatch
+	started       []startedProcess
+	failProcessId string
+}
+
+func (c *outboxTestClient) BatchPublishToLocalQueue(
+	ctx context.Context, processId string, messages ...xc.LocalQueuePublishMessage,
+) error {
+	if processId == c.failProcessId {
+		return errors.New("failed to publish")
+	}
+	namespace, _ := xc.GetNamespaceFromContext(ctx)
+	c.published = append(c.published, publishedBatch{
+		namespace: namespace,
+		processId: processId,
+		messages:  messages,
+	})
+	return nil
+}
+
+func (c *outboxTestClient) StartProcessByType(
+	ctx context.Context, processType string, processId string, input interface{}, options *xc.ProcessStartOptions,
+) (string, error) {
+	for _, started := range c.started {
+		if started.processId == processId {
+			err := xc.NewApiError(errors.New("already started"), nil, nil, &xcapi.ApiErrorResponse{}).(*xc.ApiError)
+			err.StatusCode = http.StatusConflict
+			return "", err
+		}
+	}
+	c.started = append(c.started, startedProcess{
+		processType: processType,
+		processId:   processId,
+		input:       input,
+		options:     options,
+	})
+	return "execution-" + processId, nil
+}
+
+type outboxTestProcess struct {
+	xc.ProcessDefaults
+}
+
+func newTestOutbox(t *testing.T) (*Outbox, *sql.DB) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	// the in-memory database is per connection
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+
+	outbox := NewOutbox(nil)
+	assert.Nil(t, outbox.CreateTable(context.Background(), db))
+	return outbox, db
+}
+
+func countRows(t *testing.T, db *sql.DB) int {
+	var count int
+	assert.Nil(t, db.QueryRow("SELECT COUNT(*) FROM "+DefaultTableName).Scan(&count))
+	return count
+}
+
+func TestOutboxWritesOnlyWithCommittedTransaction(t *testing.T) {
+	outbox, db := newTestOutbox(t)
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, outbox.PublishToLocalQueue(ctx, tx, "process-1", "q1", "rolled back", nil))
+	assert.Nil(t, tx.Rollback())
+	assert.Equal(t, 0, countRows(t, db))
+
+	tx, err = db.BeginTx(ctx, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, outbox.StartProcess(ctx, tx, outboxTestProcess{}, "process-1", "input", &xc.ProcessStartOptions{
+		InitialLocalAttribute: map[string]interface{}{"key": 1},
+	}))
+	assert.Nil(t, outbox.BatchPublishToLocalQueue(ctx, tx, "process-1",
+		xc.LocalQueuePublishMessage{QueueName: "q1", Payload: "message-1"},
+		xc.LocalQueuePublishMessage{QueueName: "q2", DedupSeed: xcapi.PtrString("seed")},
+	))
+	assert.Nil(t, outbox.PublishToLocalQueue(xc.WithNamespace(ctx, "ns"), tx, "process-2", "q1", nil, nil))
+	assert.Nil(t, tx.Commit())
+	assert.Equal(t, 4, countRows(t, db))
+
+	client := &outboxTestClient{}
+	relay := outbox.NewRelay(db, client, nil)
+	relayed, err := relay.RelayOnce(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, relayed)
+	assert.Equal(t, 0, countRows(t, db))
+
+	assert.Equal(t, 1, len(client.started))
+	started := client.started[0]
+	assert.Equal(t, xc.GetFinalProcessType(outboxTestProcess{}), started.processType)
+	var input string
+	assert.Nil(t, xc.GetDefaultObjectEncoder().Decode(started.input.(*xcapi.EncodedObject), &input))
+	assert.Equal(t, "input", input)
+	assert.NotNil(t, started.options.InitialLocalAttribute["key"])
+
+	// the consecutive messages to process-1 are published in one batch
+	assert.Equal(t, 2, len(client.published))
+	batch := client.published[0]
+	assert.Equal(t, xc.DefaultNamespace, batch.namespace)
+	assert.Equal(t, 2, len(batch.messages))
+	var payload string
+	assert.Nil(t, xc.GetDefaultObjectEncoder().Decode(batch.messages[0].Payload.(*xcapi.EncodedObject), &payload))
+	assert.Equal(t, "message-1", payload)
+	assert.NotNil(t, batch.messages[0].DedupUUID)
+	assert.Equal(t, "seed", *batch.messages[1].DedupSeed)
+	assert.Nil(t, batch.messages[1].DedupUUID)
+
+	assert.Equal(t, "ns", client.published[1].namespace)
+	assert.Nil(t, client.published[1].messages[0].Payload)
+}
+
+func TestRelayRetriesFailedRowsInOrder(t *testing.T) {
+	outbox, db := newTestOutbox(t)
+	ctx := context.Background()
+
+	assert.Nil(t, outbox.PublishToLocalQueue(ctx, db, "process-1", "q1", 1, nil))
+	assert.Nil(t, outbox.StartProcess(ctx, db, outboxTestProcess{}, "process-1", nil, nil))
+	assert.Nil(t, outbox.PublishToLocalQueue(ctx, db, "process-1", "q1", 2, nil))
+	assert.Nil(t, outbox.PublishToLocalQueue(ctx, db, "process-2", "q1", 3, nil))
+
+	client := &outboxTestClient{failProcessId: "process-1"}
+	var failedRowIds []int64
+	relay := outbox.NewRelay(db, client, &RelayOptions{
+		OnError: func(rowId int64, err error) {
+			failedRowIds = append(failedRowIds, rowId)
+		},
+	})
+	relayed, err := relay.RelayOnce(ctx)
+	assert.Nil(t, err)
+	// the later rows of process-1 wait for the failed one
+	assert.Equal(t, 1, relayed)
+	assert.Equal(t, []int64{1}, failedRowIds)
+	assert.Equal(t, 0, len(client.started))
+	assert.Equal(t, 3, countRows(t, db))
+
+	var attempts int
+	var lastError string
+	assert.Nil(t, db.QueryRow("SELECT attempts, last_error FROM "+DefaultTableName+" WHERE id = 1").
+		Scan(&attempts, &lastError))
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, "failed to publish", lastError)
+
+	var request string
+	assert.Nil(t, db.QueryRow("SELECT request FROM "+DefaultTableName+" WHERE id = 1").Scan(&request))
+	var req publishToLocalQueueRequest
+	assert.Nil(t, json.Unmarshal([]byte(request), &req))
+	assert.NotNil(t, req.DedupUUID)
+
+	client.failProcessId = ""
+	relayed, err = relay.RelayOnce(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, relayed)
+	assert.Equal(t, 1, len(client.started))
+	assert.Equal(t, 0, countRows(t, db))
+
+	// the DedupUUID is stored in the row, so that it's the same across the attempts
+	assert.Equal(t, *req.DedupUUID, *client.published[1].messages[0].DedupUUID)
+	assert.NotEqual(t, *req.DedupUUID, *client.published[0].messages[0].DedupUUID)
+}
+
+func TestRelayTreatsAlreadyStartedAsSuccessAndBlocksExhaustedRows(t *testing.T) {
+	outbox, db := newTestOutbox(t)
+	ctx := context.Background()
+
+	client := &outboxTestClient{
+		started:       []startedProcess{{processId: "process-1"}},
+		failProcessId: "process-2",
+	}
+	assert.Nil(t, outbox.StartProcess(ctx, db, outboxTestProcess{}, "process-1", nil, nil))
+	assert.Nil(t, outbox.PublishToLocalQueue(ctx, db, "process-2", "q1", nil, nil))
+
+	var errs []error
+	relay := outbox.NewRelay(db, client, &RelayOptions{
+		MaxAttempts: 2,
+		OnError: func(rowId int64, err error) {
+			errs = append(errs, err)
+		},
+	})
+	for i := 0; i < 3; i++ {
+		_, err := relay.RelayOnce(ctx)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 1, len(client.started))
+	// the exhausted row is left in the table, and reported without being sent again
+	assert.Equal(t, 1, countRows(t, db))
+	var attempts int
+	assert.Nil(t, db.QueryRow("SELECT attempts FROM "+DefaultTableName).Scan(&attempts))
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 3, len(errs))
+	assert.True(t, errors.Is(errs[2], ErrMaxAttemptsReached))
+
+	// the later rows of the process are blocked by the exhausted row, even if they could be sent
+	client.failProcessId = ""
+	assert.Nil(t, outbox.StartProcess(ctx, db, outboxTestProcess{}, "process-2", nil, nil))
+	relayed, err := relay.RelayOnce(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, relayed)
+	assert.Equal(t, 2, countRows(t, db))
+	assert.Equal(t, 0, len(client.published))
+}
+
+func TestRelayReadsPastBlockedRows(t *testing.T) {
+	outbox, db := newTestOutbox(t)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, outbox.PublishToLocalQueue(ctx, db, "process-1", "q1", i, nil))
+	}
+	assert.Nil(t, outbox.PublishToLocalQueue(ctx, db, "process-2", "q1", nil, nil))
+	assert.Nil(t, outbox.PublishToLocalQueue(ctx, db, "process-3", "q1", nil, nil))
+
+	client := &outboxTestClient{failProcessId: "process-1"}
+	relay := outbox.NewRelay(db, client, &RelayOptions{
+		BatchSize: 2,
+	})
+	// the first page is all of the failed process-1, the rows of the other processes are in the later pages
+	relayed, err := relay.RelayOnce(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, relayed)
+	assert.Equal(t, 2, len(client.published))
+	assert.Equal(t, "process-2", client.published[0].processId)
+	assert.Equal(t, "process-3", client.published[1].processId)
+	assert.Equal(t, 3, countRows(t, db))
+}
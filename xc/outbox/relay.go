@@ -0,0 +1,276 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/xcherryio/sdk-go/xc"
+)
+
+type RelayOptions struct {
+	// BatchSize is the max number of rows to read from the table in one page, and to send in one round
+	// Default: DefaultRelayBatchSize when set as 0
+	BatchSize int
+	// PollInterval is the interval of polling the table in Run
+	// Default: DefaultRelayPollInterval when set as 0
+	PollInterval time.Duration
+	// MaxAttempts is the max number of attempts to send a row, the rows reaching it are left in the table
+	// with the last_error for manual handling. They are not sent again, but still block the later rows of the same
+	// process to keep the order, and are reported to OnError with ErrMaxAttemptsReached in every round
+	// Default: 0, means no limit
+	MaxAttempts int
+	// OnError is invoked when a row fails to be sent, e.g. for logging
+	OnError func(rowId int64, err error)
+}
+
+const (
+	DefaultRelayBatchSize    = 100
+	DefaultRelayPollInterval = time.Second
+)
+
+// ErrMaxAttemptsReached is reported to RelayOptions.OnError for the rows that have reached the MaxAttempts,
+// wrapped with the last error of the row
+var ErrMaxAttemptsReached = errors.New("outbox row has reached the max attempts")
+
+// Relay drains the outbox table by sending the requests through the xc.Client, in the order of the row ids.
+// A row is deleted after it's sent. A failed row is retried in the next round, and the later rows of the same process
+// wait for it so that the order is kept.
+// Sending is at least once, but idempotent on the server, see Outbox.PublishToLocalQueue and Outbox.StartProcess
+type Relay struct {
+	outbox  *Outbox
+	db      *sql.DB
+	client  xc.Client
+	options RelayOptions
+}
+
+// NewRelay returns a Relay of the outbox table in the db
+func (o *Outbox) NewRelay(db *sql.DB, client xc.Client, options *RelayOptions) *Relay {
+	var opts RelayOptions
+	if options != nil {
+		opts = *options
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultRelayBatchSize
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultRelayPollInterval
+	}
+	return &Relay{
+		outbox:  o,
+		db:      db,
+		client:  client,
+		options: opts,
+	}
+}
+
+// Run relays the rows until the ctx is done
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		relayed, err := r.RelayOnce(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err == nil && relayed >= r.options.BatchSize {
+			// there may be more rows
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.options.PollInterval):
+		}
+	}
+}
+
+// RelayOnce reads the rows from the table page by page and sends them, until BatchSize rows are sent
+// or the end of the table. The pages are read past the rows blocked by the failed processes,
+// so that they don't starve the other processes.
+// returns the number of rows sent successfully
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	relayed := 0
+	failedProcesses := map[string]bool{}
+	var afterId int64
+	for {
+		rows, err := r.readRows(ctx, afterId)
+		if err != nil {
+			return relayed, err
+		}
+		pageRelayed, err := r.relayRows(ctx, rows, failedProcesses)
+		relayed += pageRelayed
+		if err != nil {
+			return relayed, err
+		}
+		if len(rows) < r.options.BatchSize || relayed >= r.options.BatchSize {
+			return relayed, nil
+		}
+		afterId = rows[len(rows)-1].id
+	}
+}
+
+// relayRows sends the rows of a page, skipping the processes in failedProcesses and adding the failed ones to it
+func (r *Relay) relayRows(ctx context.Context, rows []outboxRow, failedProcesses map[string]bool) (int, error) {
+	relayed := 0
+	for i := 0; i < len(rows); {
+		// consecutive messages to the same process are published in one request
+		batch := []outboxRow{rows[i]}
+		for i+len(batch) < len(rows) && rows[i].canBatchWith(rows[i+len(batch)]) {
+			batch = append(batch, rows[i+len(batch)])
+		}
+		i += len(batch)
+
+		processKey := rows[i-1].getProcessKey()
+		if failedProcesses[processKey] {
+			continue
+		}
+		if r.isExhausted(batch[0]) {
+			// the first row of a process has the most attempts, as it's in every failed batch of the process
+			failedProcesses[processKey] = true
+			if r.options.OnError != nil {
+				r.options.OnError(batch[0].id, fmt.Errorf("%w: %v", ErrMaxAttemptsReached, batch[0].lastError.String))
+			}
+			continue
+		}
+		if err := r.send(ctx, batch); err != nil {
+			failedProcesses[processKey] = true
+			if err := r.markFailed(ctx, batch, err); err != nil {
+				return relayed, err
+			}
+			continue
+		}
+		if err := r.delete(ctx, batch); err != nil {
+			return relayed, err
+		}
+		relayed += len(batch)
+	}
+	return relayed, nil
+}
+
+type outboxRow struct {
+	id          int64
+	requestType string
+	namespace   string
+	processId   string
+	request     string
+	attempts    int
+	lastError   sql.NullString
+}
+
+func (r outboxRow) getProcessKey() string {
+	return r.namespace + "/" + r.processId
+}
+
+func (r outboxRow) canBatchWith(next outboxRow) bool {
+	return r.requestType == requestTypePublishToLocalQueue && next.requestType == requestTypePublishToLocalQueue &&
+		r.getProcessKey() == next.getProcessKey()
+}
+
+// readRows reads a page of up to BatchSize rows after the afterId
+func (r *Relay) readRows(ctx context.Context, afterId int64) ([]outboxRow, error) {
+	opts := r.outbox.options
+	// the rows that have reached the MaxAttempts are read as well, so that they block the later rows of the process
+	query := "SELECT id, request_type, namespace, process_id, request, attempts, last_error FROM " + opts.TableName +
+		" WHERE id > " + opts.Dialect.Placeholder(1) + fmt.Sprintf(" ORDER BY id LIMIT %d", r.options.BatchSize)
+
+	sqlRows, err := r.db.QueryContext(ctx, query, afterId)
+	if err != nil {
+		return nil, err
+	}
+	defer sqlRows.Close()
+	var rows []outboxRow
+	for sqlRows.Next() {
+		var row outboxRow
+		if err := sqlRows.Scan(
+			&row.id, &row.requestType, &row.namespace, &row.processId, &row.request, &row.attempts, &row.lastError,
+		); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, sqlRows.Err()
+}
+
+func (r *Relay) send(ctx context.Context, batch []outboxRow) error {
+	ctx = xc.WithNamespace(ctx, batch[0].namespace)
+	processId := batch[0].processId
+	switch batch[0].requestType {
+	case requestTypePublishToLocalQueue:
+		var messages []xc.LocalQueuePublishMessage
+		for _, row := range batch {
+			var req publishToLocalQueueRequest
+			if err := json.Unmarshal([]byte(row.request), &req); err != nil {
+				return err
+			}
+			message := xc.LocalQueuePublishMessage{
+				QueueName: req.QueueName,
+				DedupSeed: req.DedupSeed,
+				DedupUUID: req.DedupUUID,
+			}
+			if req.Payload != nil {
+				message.Payload = req.Payload
+			}
+			messages = append(messages, message)
+		}
+		return r.client.BatchPublishToLocalQueue(ctx, processId, messages...)
+	case requestTypeStartProcess:
+		var req startProcessRequest
+		if err := json.Unmarshal([]byte(batch[0].request), &req); err != nil {
+			return err
+		}
+		options := &xc.ProcessStartOptions{
+			TimeoutSeconds: req.TimeoutSeconds,
+			IdReusePolicy:  req.IdReusePolicy,
+		}
+		if len(req.InitialLocalAttribute) > 0 {
+			options.InitialLocalAttribute = map[string]interface{}{}
+			for key, attr := range req.InitialLocalAttribute {
+				options.InitialLocalAttribute[key] = attr
+			}
+		}
+		var input interface{}
+		if req.Input != nil {
+			input = req.Input
+		}
+		_, err := r.client.StartProcessByType(ctx, req.ProcessType, processId, input, options)
+		if xc.IsProcessAlreadyStartedError(err) {
+			return nil
+		}
+		return err
+	default:
+		return fmt.Errorf("unknown outbox request type %v", batch[0].requestType)
+	}
+}
+
+// isExhausted returns true if the row has reached the MaxAttempts
+func (r *Relay) isExhausted(row outboxRow) bool {
+	return r.options.MaxAttempts > 0 && row.attempts >= r.options.MaxAttempts
+}
+
+func (r *Relay) delete(ctx context.Context, batch []outboxRow) error {
+	opts := r.outbox.options
+	query := "DELETE FROM " + opts.TableName + " WHERE id = " + opts.Dialect.Placeholder(1)
+	for _, row := range batch {
+		if _, err := r.db.ExecContext(ctx, query, row.id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Relay) markFailed(ctx context.Context, batch []outboxRow, sendErr error) error {
+	opts := r.outbox.options
+	query := "UPDATE " + opts.TableName + " SET attempts = attempts + 1, last_error = " + opts.Dialect.Placeholder(1) +
+		" WHERE id = " + opts.Dialect.Placeholder(2)
+	for _, row := range batch {
+		if r.options.OnError != nil {
+			r.options.OnError(row.id, sendErr)
+		}
+		if _, err := r.db.ExecContext(ctx, query, sendErr.Error(), row.id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,10 +3,27 @@ package xc
 import "github.com/xcherryio/apis/goapi/xcapi"
 
 type Persistence interface {
-	// GetLocalAttribute returns the local attribute value
+	// GetLocalAttribute returns the local attribute value, panics on error
+	// If the attribute is not set, deleted or expired, the resultPtr is set to the DefaultValue of the LocalAttributeDef,
+	// or left untouched if there is no default value
 	GetLocalAttribute(key string, resultPtr interface{})
-	// SetLocalAttribute sets the local attribute value
+	// SetLocalAttribute sets the local attribute value, panics on error
 	SetLocalAttribute(key string, value interface{})
+	// GetLocalAttributeE returns the local attribute value, returns error instead of panic
+	GetLocalAttributeE(key string, resultPtr interface{}) error
+	// SetLocalAttributeE sets the local attribute value, returns error instead of panic
+	SetLocalAttributeE(key string, value interface{}) error
+	// DeleteLocalAttribute deletes the local attribute value, panics on error
+	DeleteLocalAttribute(key string)
+	// DeleteLocalAttributeE deletes the local attribute value, returns error instead of panic
+	DeleteLocalAttributeE(key string) error
+	// HasLocalAttribute returns true if the local attribute is loaded and set with a non-nil value(a typed nil, e.g. a nil
+	// pointer, counts as nil), which is not expired.
+	// The default value doesn't count
+	HasLocalAttribute(key string) bool
+	// GetUnknownLocalAttributeKeys returns the keys of the loaded local attributes that are not defined
+	// in the LocalAttributesSchema, e.g. written by a newer version of the worker. They are skipped when loading
+	GetUnknownLocalAttributeKeys() []string
 
 	// getLocalAttributesToReturn returns the local attributes to update
 	getLocalAttributesToUpdate() []xcapi.KeyValue
@@ -1,67 +1,163 @@
 package xc
 
-import "github.com/xcherryio/apis/goapi/xcapi"
+import (
+	"time"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
 
 type persistenceImpl struct {
 
 	// for local attributes
+	localAttrSchema       *LocalAttributesSchema
 	localAttrKeys         map[string]bool
 	currLocalAttrs        map[string]xcapi.EncodedObject
 	currUpdatedLocalAttrs map[string]xcapi.EncodedObject
+	unknownLocalAttrKeys  []string
+	encoder               ObjectEncoder
+
+	now func() time.Time
 }
 
 func NewPersistenceImpl(
 	localAttrKeys map[string]bool,
 	currLocalAttrs *xcapi.LoadLocalAttributesResponse,
 ) Persistence {
+	return NewPersistenceImplWithSchema(&LocalAttributesSchema{
+		LocalAttributeKeys: localAttrKeys,
+	}, currLocalAttrs)
+}
+
+// NewPersistenceImplWithSchema returns a Persistence with the LocalAttributesSchema applied to the loaded attributes,
+// see LocalAttributesSchema for the defaults, TTLs and migrations. It panics if failed to upgrade an attribute
+func NewPersistenceImplWithSchema(
+	localAttrSchema *LocalAttributesSchema,
+	currLocalAttrs *xcapi.LoadLocalAttributesResponse,
+) Persistence {
+	p, err := newPersistenceImpl(localAttrSchema, currLocalAttrs, GetDefaultObjectEncoder(), time.Now)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
 
-	currLocalAttrsMap := map[string]xcapi.EncodedObject{}
+func newPersistenceImpl(
+	localAttrSchema *LocalAttributesSchema,
+	currLocalAttrs *xcapi.LoadLocalAttributesResponse,
+	encoder ObjectEncoder,
+	now func() time.Time,
+) (*persistenceImpl, error) {
+	if localAttrSchema == nil {
+		localAttrSchema = &LocalAttributesSchema{}
+	}
+
+	var loaded []xcapi.KeyValue
 	if currLocalAttrs != nil {
-		for _, kv := range currLocalAttrs.Attributes {
-			if _, ok := localAttrKeys[kv.Key]; !ok {
-				panic("local attribute not found " + kv.Key)
-			}
-			currLocalAttrsMap[kv.Key] = kv.Value
-		}
+		loaded = currLocalAttrs.Attributes
+	}
+	currLocalAttrsMap, currUpdatedLocalAttrsMap, unknownKeys, err := loadLocalAttributes(
+		localAttrSchema, encoder, loaded, now())
+	if err != nil {
+		return nil, err
 	}
 
 	return &persistenceImpl{
-		localAttrKeys:         localAttrKeys,
+		localAttrSchema:       localAttrSchema,
+		localAttrKeys:         localAttrSchema.LocalAttributeKeys,
 		currLocalAttrs:        currLocalAttrsMap,
-		currUpdatedLocalAttrs: map[string]xcapi.EncodedObject{},
-	}
+		currUpdatedLocalAttrs: currUpdatedLocalAttrsMap,
+		unknownLocalAttrKeys:  unknownKeys,
+		encoder:               encoder,
+		now:                   now,
+	}, nil
 }
 
 func (p *persistenceImpl) GetLocalAttribute(key string, resultPtr interface{}) {
-	_, ok := p.localAttrKeys[key]
-	if !ok {
-		panic("local attribute not found " + key)
+	err := p.GetLocalAttributeE(key, resultPtr)
+	if err != nil {
+		panic(err)
 	}
+}
 
-	curVal, ok := p.currLocalAttrs[key]
-	if !ok {
-		return
+func (p *persistenceImpl) SetLocalAttribute(key string, value interface{}) {
+	err := p.SetLocalAttributeE(key, value)
+	if err != nil {
+		panic(err)
 	}
+}
 
-	err := GetDefaultObjectEncoder().Decode(&curVal, resultPtr)
+func (p *persistenceImpl) DeleteLocalAttribute(key string) {
+	err := p.DeleteLocalAttributeE(key)
 	if err != nil {
 		panic(err)
 	}
 }
 
-func (p *persistenceImpl) SetLocalAttribute(key string, value interface{}) {
+func (p *persistenceImpl) GetLocalAttributeE(key string, resultPtr interface{}) error {
+	_, ok := p.localAttrKeys[key]
+	if !ok {
+		return NewInvalidArgumentError("local attribute not found %v", key)
+	}
+
+	curVal, ok := p.currLocalAttrs[key]
+	curVal, _ = unwrapLocalAttribute(curVal)
+	if !ok || isNullLocalAttribute(curVal) {
+		defaultVal, ok := p.localAttrSchema.LocalAttributeDefaults[key]
+		if !ok {
+			return nil
+		}
+		// round trip with the encoder so that the resultPtr can be either a value or pointer type
+		encodedDefault, err := GetDefaultObjectEncoder().Encode(defaultVal)
+		if err != nil {
+			return err
+		}
+		return GetDefaultObjectEncoder().Decode(encodedDefault, resultPtr)
+	}
+
+	return getLocalAttributeDecoder(p.encoder, &curVal).Decode(&curVal, resultPtr)
+}
+
+func (p *persistenceImpl) SetLocalAttributeE(key string, value interface{}) error {
 	_, ok := p.localAttrKeys[key]
 	if !ok {
-		panic("local attribute is not defined/registered in the PersistenceSchema: " + key)
+		return NewInvalidArgumentError("local attribute is not defined/registered in the PersistenceSchema: %v", key)
 	}
 
-	encodedVal, err := GetDefaultObjectEncoder().Encode(value)
+	encodedVal, err := encodeLocalAttribute(p.localAttrSchema, p.encoder, key, value, p.now())
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	p.currLocalAttrs[key] = *encodedVal
 	p.currUpdatedLocalAttrs[key] = *encodedVal
+	return nil
+}
+
+func (p *persistenceImpl) DeleteLocalAttributeE(key string) error {
+	_, ok := p.localAttrKeys[key]
+	if !ok {
+		return NewInvalidArgumentError("local attribute is not defined/registered in the PersistenceSchema: %v", key)
+	}
+
+	p.currLocalAttrs[key] = xcapi.EncodedObject{}
+	p.currUpdatedLocalAttrs[key] = xcapi.EncodedObject{}
+	return nil
+}
+
+func (p *persistenceImpl) HasLocalAttribute(key string) bool {
+	curVal, ok := p.currLocalAttrs[key]
+	curVal, _ = unwrapLocalAttribute(curVal)
+	return ok && !isNullLocalAttribute(curVal)
+}
+
+func (p *persistenceImpl) GetUnknownLocalAttributeKeys() []string {
+	return p.unknownLocalAttrKeys
+}
+
+// isNullLocalAttribute returns true if the local attribute is deleted, or set with a nil value,
+// including a typed nil(e.g. a nil pointer) that is encoded as null by the default ObjectEncoder
+func isNullLocalAttribute(encoded xcapi.EncodedObject) bool {
+	return encoded.GetData() == "" || encoded.GetData() == "null"
 }
 
 func (p *persistenceImpl) getLocalAttributesToUpdate() []xcapi.KeyValue {
@@ -0,0 +1,161 @@
+package xc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+func newTestLocalAttributesSchema() *LocalAttributesSchema {
+	return NewLocalAttributesSchema(nil,
+		NewLocalAttributeDef("plain", LoadNoLock),
+		NewLocalAttributeDef("withDefault", LoadNoLock).WithDefault(10),
+		NewLocalAttributeDef("withTTL", LoadNoLock).WithTTL(time.Minute),
+	)
+}
+
+func newTestPersistenceImpl(
+	schema *LocalAttributesSchema, currLocalAttrs *xcapi.LoadLocalAttributesResponse, now func() time.Time,
+) *persistenceImpl {
+	p, err := newPersistenceImpl(schema, currLocalAttrs, GetDefaultObjectEncoder(), now)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func getTestLocalAttributesToUpdate(p Persistence) map[string]xcapi.EncodedObject {
+	res := map[string]xcapi.EncodedObject{}
+	for _, kv := range p.getLocalAttributesToUpdate() {
+		res[kv.Key] = kv.Value
+	}
+	return res
+}
+
+func TestPersistenceDeleteLocalAttribute(t *testing.T) {
+	encoded, err := GetDefaultObjectEncoder().Encode("value")
+	assert.Nil(t, err)
+	p := newTestPersistenceImpl(newTestLocalAttributesSchema(), &xcapi.LoadLocalAttributesResponse{
+		Attributes: []xcapi.KeyValue{*xcapi.NewKeyValue("plain", *encoded)},
+	}, time.Now)
+	assert.True(t, p.HasLocalAttribute("plain"))
+
+	p.DeleteLocalAttribute("plain")
+	assert.False(t, p.HasLocalAttribute("plain"))
+	var val string
+	p.GetLocalAttribute("plain", &val)
+	assert.Equal(t, "", val)
+	assert.Equal(t, map[string]xcapi.EncodedObject{"plain": {}}, getTestLocalAttributesToUpdate(p))
+
+	assert.Error(t, p.DeleteLocalAttributeE("unknown"))
+}
+
+func TestPersistenceHasLocalAttribute(t *testing.T) {
+	p := newTestPersistenceImpl(newTestLocalAttributesSchema(), nil, time.Now)
+	assert.False(t, p.HasLocalAttribute("plain"))
+	assert.False(t, p.HasLocalAttribute("unknown"))
+
+	var nilPtr *string
+	p.SetLocalAttribute("plain", nilPtr)
+	assert.False(t, p.HasLocalAttribute("plain"))
+	p.SetLocalAttribute("withDefault", nilPtr)
+	assert.False(t, p.HasLocalAttribute("withDefault"))
+	var val int
+	p.GetLocalAttribute("withDefault", &val)
+	assert.Equal(t, 10, val)
+
+	p.SetLocalAttribute("plain", "")
+	assert.True(t, p.HasLocalAttribute("plain"))
+}
+
+func TestPersistenceLocalAttributeErrors(t *testing.T) {
+	encoded, err := GetDefaultObjectEncoder().Encode("value")
+	assert.Nil(t, err)
+	p := newTestPersistenceImpl(newTestLocalAttributesSchema(), &xcapi.LoadLocalAttributesResponse{
+		Attributes: []xcapi.KeyValue{*xcapi.NewKeyValue("plain", *encoded)},
+	}, time.Now)
+
+	var val string
+	assert.Error(t, p.GetLocalAttributeE("unknown", &val))
+	assert.Error(t, p.SetLocalAttributeE("unknown", "value"))
+	assert.Panics(t, func() { p.GetLocalAttribute("unknown", &val) })
+	assert.Panics(t, func() { p.SetLocalAttribute("unknown", "value") })
+
+	// decoding error is returned instead of panic
+	var intVal int
+	assert.Error(t, p.GetLocalAttributeE("plain", &intVal))
+	assert.Nil(t, p.GetLocalAttributeE("plain", &val))
+	assert.Equal(t, "value", val)
+
+	obj := NewObject(encoded, GetDefaultObjectEncoder())
+	assert.Error(t, obj.GetE(&intVal))
+	assert.Nil(t, obj.GetE(&val))
+}
+
+func TestPersistenceLocalAttributeDefault(t *testing.T) {
+	p := newTestPersistenceImpl(newTestLocalAttributesSchema(), nil, time.Now)
+
+	var val int
+	p.GetLocalAttribute("withDefault", &val)
+	assert.Equal(t, 10, val)
+	var valPtr *int
+	p.GetLocalAttribute("withDefault", &valPtr)
+	assert.Equal(t, 10, *valPtr)
+	assert.False(t, p.HasLocalAttribute("withDefault"))
+	assert.Empty(t, getTestLocalAttributesToUpdate(p))
+
+	p.SetLocalAttribute("withDefault", 20)
+	p.GetLocalAttribute("withDefault", &val)
+	assert.Equal(t, 20, val)
+
+	p.DeleteLocalAttribute("withDefault")
+	p.GetLocalAttribute("withDefault", &val)
+	assert.Equal(t, 10, val)
+}
+
+func TestPersistenceLocalAttributeTTL(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	p := newTestPersistenceImpl(newTestLocalAttributesSchema(), nil, func() time.Time { return now })
+
+	p.SetLocalAttribute("withTTL", "value")
+	p.SetLocalAttribute("plain", "value")
+	updated := getTestLocalAttributesToUpdate(p)
+	assert.Equal(t, "golangJson", updated["withTTL"].Encoding)
+	_, meta := unwrapLocalAttribute(updated["withTTL"])
+	assert.Equal(t, time.Unix(1700000060, 0), meta.expireAt)
+	assert.Equal(t, xcapi.EncodedObject{Encoding: "golangJson", Data: `"value"`}, updated["plain"])
+
+	var val string
+	p.GetLocalAttribute("withTTL", &val)
+	assert.Equal(t, "value", val)
+
+	loaded := &xcapi.LoadLocalAttributesResponse{
+		Attributes: []xcapi.KeyValue{
+			*xcapi.NewKeyValue("withTTL", updated["withTTL"]),
+			*xcapi.NewKeyValue("plain", updated["plain"]),
+		},
+	}
+
+	notExpired := newTestPersistenceImpl(newTestLocalAttributesSchema(), loaded,
+		func() time.Time { return now.Add(time.Second * 59) })
+	assert.True(t, notExpired.HasLocalAttribute("withTTL"))
+	assert.Empty(t, getTestLocalAttributesToUpdate(notExpired))
+
+	expired := newTestPersistenceImpl(newTestLocalAttributesSchema(), loaded,
+		func() time.Time { return now.Add(time.Minute) })
+	assert.False(t, expired.HasLocalAttribute("withTTL"))
+	assert.True(t, expired.HasLocalAttribute("plain"))
+	val = ""
+	expired.GetLocalAttribute("withTTL", &val)
+	assert.Equal(t, "", val)
+	// the expired value is deleted in memory only, because the key is loaded without lock
+	assert.Empty(t, getTestLocalAttributesToUpdate(expired))
+
+	lockedSchema := NewLocalAttributesSchema(xcapi.EXCLUSIVE_LOCK.Ptr(),
+		NewLocalAttributeDef("withTTL", LoadWithLock).WithTTL(time.Minute))
+	expired = newTestPersistenceImpl(lockedSchema, loaded, func() time.Time { return now.Add(time.Minute) })
+	assert.False(t, expired.HasLocalAttribute("withTTL"))
+	assert.Equal(t, map[string]xcapi.EncodedObject{"withTTL": {}}, getTestLocalAttributesToUpdate(expired))
+}
@@ -1,6 +1,10 @@
 package xc
 
-import "github.com/xcherryio/apis/goapi/xcapi"
+import (
+	"time"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
 
 type PersistenceSchema struct {
 	// LocalAttributeSchema is the schema for local attributes
@@ -51,8 +55,25 @@ type NamedPersistencePolicy struct {
 type LocalAttributesSchema struct {
 	LocalAttributeKeys          map[string]bool
 	DefaultLocalAttributePolicy LocalAttributePolicy
+	// LocalAttributeDefaults is the key to the default value returned when the attribute is not set
+	LocalAttributeDefaults map[string]interface{}
+	// LocalAttributeTTLs is the key to the TTL of the attribute value since it's set
+	LocalAttributeTTLs map[string]time.Duration
+	// LocalAttributeAliases is the old key to the key it's renamed to.
+	// The value of the old key is moved to the key when loaded, if the key is not set
+	LocalAttributeAliases map[string]string
+	// DeprecatedLocalAttributeKeys are the keys no longer used, which are skipped when loaded
+	DeprecatedLocalAttributeKeys map[string]bool
+	// LocalAttributeVersions is the key to the current version of the value
+	LocalAttributeVersions map[string]int
+	// LocalAttributeUpgrades is the key to the function to upgrade the value of an older version when loaded
+	LocalAttributeUpgrades map[string]LocalAttributeUpgradeFunc
 }
 
+// LocalAttributeUpgradeFunc upgrades the value of a local attribute from an older version to the current version.
+// The values written before the attribute has a version are version 0
+type LocalAttributeUpgradeFunc func(fromVersion int, oldValue Object) (interface{}, error)
+
 type LocalAttributePolicy struct {
 	LocalAttributeKeysNoLock   map[string]bool
 	LocalAttributeKeysWithLock map[string]bool
@@ -100,6 +121,17 @@ const (
 type LocalAttributeDef struct {
 	Key                string
 	DefaultLoadingType LocalAttributeLoadingType
+	// DefaultValue is the optional value returned by GetLocalAttribute when the attribute is not set or deleted
+	DefaultValue interface{}
+	// An expired value is treated as not set, and deleted when loaded with lock by a state
+	TTL time.Duration
+	// Aliases are the optional old keys of the attribute, see LocalAttributesSchema.LocalAttributeAliases
+	Aliases []string
+	// Version and Upgrade are the optional current version of the value and the function to upgrade older values
+	Version int
+	Upgrade LocalAttributeUpgradeFunc
+	// Deprecated is true if the attribute is no longer used, see NewDeprecatedLocalAttributeDef
+	Deprecated bool
 }
 
 func NewLocalAttributeDef(key string, defaultLoadingType LocalAttributeLoadingType) LocalAttributeDef {
@@ -109,19 +141,106 @@ func NewLocalAttributeDef(key string, defaultLoadingType LocalAttributeLoadingTy
 	}
 }
 
+// NewDeprecatedLocalAttributeDef declares a key no longer used, so that the values of running processes
+// are skipped instead of failing the loading
+func NewDeprecatedLocalAttributeDef(key string) LocalAttributeDef {
+	return LocalAttributeDef{
+		Key:                key,
+		DefaultLoadingType: NotLoad,
+		Deprecated:         true,
+	}
+}
+
+func (d LocalAttributeDef) GetLocalAttributeDef() LocalAttributeDef {
+	return d
+}
+
+// WithDefault returns a copy of the LocalAttributeDef with the default value
+func (d LocalAttributeDef) WithDefault(defaultValue interface{}) LocalAttributeDef {
+	d.DefaultValue = defaultValue
+	return d
+}
+
+// WithTTL returns a copy of the LocalAttributeDef with the TTL
+func (d LocalAttributeDef) WithTTL(ttl time.Duration) LocalAttributeDef {
+	d.TTL = ttl
+	return d
+}
+
+// WithAliases returns a copy of the LocalAttributeDef with the old keys that the attribute is renamed from
+func (d LocalAttributeDef) WithAliases(oldKeys ...string) LocalAttributeDef {
+	d.Aliases = append(append([]string{}, d.Aliases...), oldKeys...)
+	return d
+}
+
+// WithVersion returns a copy of the LocalAttributeDef with the current version of the value,
+// and the function to upgrade the values of older versions when loaded
+func (d LocalAttributeDef) WithVersion(version int, upgrade LocalAttributeUpgradeFunc) LocalAttributeDef {
+	d.Version = version
+	d.Upgrade = upgrade
+	return d
+}
+
 func NewEmptyLocalAttributesSchema() *LocalAttributesSchema {
 	return nil
 }
 
+// NewLocalAttributesSchema creates a new LocalAttributesSchema of the LocalAttributeDefs,
+// see NewLocalAttributesSchemaFromDeclarations for the typed LocalAttr
 func NewLocalAttributesSchema(
 	LockingType *xcapi.LockType,
 	localAttributesDef ...LocalAttributeDef,
+) *LocalAttributesSchema {
+	localAttributes := make([]LocalAttributeDeclaration, 0, len(localAttributesDef))
+	for _, def := range localAttributesDef {
+		localAttributes = append(localAttributes, def)
+	}
+	return NewLocalAttributesSchemaFromDeclarations(LockingType, localAttributes...)
+}
+
+// NewLocalAttributesSchemaFromDeclarations is the same as NewLocalAttributesSchema,
+// but accepts the typed LocalAttr as well as LocalAttributeDef
+func NewLocalAttributesSchemaFromDeclarations(
+	LockingType *xcapi.LockType,
+	localAttributes ...LocalAttributeDeclaration,
 ) *LocalAttributesSchema {
 	keys := map[string]bool{}
 	keysWithLock := map[string]bool{}
 	keysNoLock := map[string]bool{}
-	for _, def := range localAttributesDef {
+	defaults := map[string]interface{}{}
+	ttls := map[string]time.Duration{}
+	aliases := map[string]string{}
+	deprecatedKeys := map[string]bool{}
+	versions := map[string]int{}
+	upgrades := map[string]LocalAttributeUpgradeFunc{}
+	for _, attr := range localAttributes {
+		def := attr.GetLocalAttributeDef()
+		if keys[def.Key] || deprecatedKeys[def.Key] {
+			panic("duplicated local attribute key " + def.Key)
+		}
+		if def.Deprecated {
+			deprecatedKeys[def.Key] = true
+			continue
+		}
 		keys[def.Key] = true
+		for _, alias := range def.Aliases {
+			if _, ok := aliases[alias]; ok {
+				panic("duplicated local attribute alias " + alias)
+			}
+			aliases[alias] = def.Key
+		}
+		if def.Version > 0 {
+			versions[def.Key] = def.Version
+			if def.Upgrade != nil {
+				upgrades[def.Key] = def.Upgrade
+			}
+		}
+		if def.DefaultValue != nil {
+			defaults[def.Key] = def.DefaultValue
+		}
+		if def.TTL > 0 {
+			ttls[def.Key] = def.TTL
+		}
 		switch def.DefaultLoadingType {
 		case NotLoad:
 		case LoadWithLock:
@@ -140,6 +259,12 @@ func NewLocalAttributesSchema(
 			LocalAttributeKeysWithLock: keysWithLock,
 			LockingType:                LockingType,
 		},
+		LocalAttributeDefaults:       defaults,
+		LocalAttributeTTLs:           ttls,
+		LocalAttributeAliases:        aliases,
+		DeprecatedLocalAttributeKeys: deprecatedKeys,
+		LocalAttributeVersions:       versions,
+		LocalAttributeUpgrades:       upgrades,
 	}
 }
 
@@ -273,6 +398,48 @@ func (s PersistenceSchema) ValidateLocalAttributeForRegistry() (map[string]bool,
 					"DefaultLocalAttributePolicy KeysNoLock and KeysWithLock contains duplicated key " + key)
 			}
 		}
+
+		for key := range s.LocalAttributeSchema.LocalAttributeDefaults {
+			if _, ok := localAttributeKeys[key]; !ok {
+				return nil, NewProcessDefinitionError("LocalAttributeDefaults contains invalid key " + key)
+			}
+			if _, err := GetDefaultObjectEncoder().Encode(s.LocalAttributeSchema.LocalAttributeDefaults[key]); err != nil {
+				return nil, NewProcessDefinitionError("LocalAttributeDefaults contains value not encodable for key " + key)
+			}
+		}
+
+		for alias, key := range s.LocalAttributeSchema.LocalAttributeAliases {
+			if _, ok := localAttributeKeys[key]; !ok {
+				return nil, NewProcessDefinitionError("LocalAttributeAliases contains invalid key " + key)
+			}
+			if _, ok := localAttributeKeys[alias]; ok || s.LocalAttributeSchema.DeprecatedLocalAttributeKeys[alias] {
+				return nil, NewProcessDefinitionError("LocalAttributeAliases contains alias that is also a key " + alias)
+			}
+		}
+
+		for key := range s.LocalAttributeSchema.DeprecatedLocalAttributeKeys {
+			if _, ok := localAttributeKeys[key]; ok {
+				return nil, NewProcessDefinitionError("DeprecatedLocalAttributeKeys contains key in use " + key)
+			}
+		}
+
+		for key, version := range s.LocalAttributeSchema.LocalAttributeVersions {
+			if _, ok := localAttributeKeys[key]; !ok {
+				return nil, NewProcessDefinitionError("LocalAttributeVersions contains invalid key " + key)
+			}
+			if _, ok := s.LocalAttributeSchema.LocalAttributeUpgrades[key]; version > 0 && !ok {
+				return nil, NewProcessDefinitionError("LocalAttributeUpgrades contains no upgrade function for key " + key)
+			}
+		}
+
+		for key, ttl := range s.LocalAttributeSchema.LocalAttributeTTLs {
+			if _, ok := localAttributeKeys[key]; !ok {
+				return nil, NewProcessDefinitionError("LocalAttributeTTLs contains invalid key " + key)
+			}
+			if ttl <= 0 {
+				return nil, NewProcessDefinitionError("LocalAttributeTTLs contains non-positive TTL for key " + key)
+			}
+		}
 	}
 	return localAttributeKeys, nil
 }
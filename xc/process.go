@@ -14,6 +14,8 @@ type Process interface {
 	GetAsyncStateSchema() StateSchema
 	// GetPersistenceSchema defines the persistence schema of the process
 	GetPersistenceSchema() PersistenceSchema
+	// GetCommunicationSchema defines the communication schema of the process, like the local queues
+	GetCommunicationSchema() CommunicationSchema
 	// GetProcessOptions defines the options for the process
 	// Note that they can be overridden by the ProcessStartOptions when starting a process
 	GetProcessOptions() ProcessOptions
@@ -43,7 +45,7 @@ func getSimpleTypeNameFromReflect(obj interface{}) string {
 //	    ProcessDefaults
 //	}
 //
-// Then myPcImpl doesn't have to implement GetProcessOptions or GetAsyncStateSchema
+// Then myPcImpl doesn't have to implement GetProcessOptions, GetAsyncStateSchema, GetPersistenceSchema or GetCommunicationSchema
 type ProcessDefaults struct {
 }
 
@@ -57,6 +59,10 @@ func (d ProcessDefaults) GetPersistenceSchema() PersistenceSchema {
 	return NewEmptyPersistenceSchema()
 }
 
+func (d ProcessDefaults) GetCommunicationSchema() CommunicationSchema {
+	return NewEmptyCommunicationSchema()
+}
+
 func (d ProcessDefaults) GetProcessOptions() ProcessOptions {
 	return NewDefaultProcessOptions()
 }
@@ -7,21 +7,28 @@ type Registry interface {
 	AddProcesses(processDefs ...Process) error
 	// GetAllRegisteredProcessTypes returns all the process types that have been registered
 	GetAllRegisteredProcessTypes() []string
+	// GetRegistryInfo returns the info of all the registered processes, sorted by process type
+	GetRegistryInfo() RegistryInfo
 
 	// below are all for internal implementation
 	getProcess(prcType string) Process
 	getProcessStartingState(prcType string) AsyncState
 	getProcessState(prcType string, id string) AsyncState
+	newProcessStateInstance(prcType string, id string) AsyncState
 	getPersistenceSchema(prcType string) PersistenceSchema
 	getLocalAttributeKeys(prcType string) map[string]bool
+	getCommunicationSchema(prcType string) CommunicationSchema
+	isAnyLocalQueueDeclared() bool
 }
 
 func NewRegistry() Registry {
 	return &registryImpl{
-		processStore:           map[string]Process{},
-		startingState:          map[string]AsyncState{},
-		stateStore:             map[string]map[string]AsyncState{},
-		persistenceSchemaStore: map[string]PersistenceSchema{},
-		localAttrKeys:          map[string]map[string]bool{},
+		processStore:             map[string]Process{},
+		startingState:            map[string]AsyncState{},
+		stateStore:               map[string]map[string]AsyncState{},
+		stateFactoryStore:        map[string]map[string]AsyncStateFactory{},
+		persistenceSchemaStore:   map[string]PersistenceSchema{},
+		localAttrKeys:            map[string]map[string]bool{},
+		communicationSchemaStore: map[string]CommunicationSchema{},
 	}
 }
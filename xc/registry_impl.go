@@ -1,11 +1,15 @@
 package xc
 
+import "sort"
+
 type registryImpl struct {
-	processStore           map[string]Process
-	persistenceSchemaStore map[string]PersistenceSchema
-	localAttrKeys          map[string]map[string]bool
-	startingState          map[string]AsyncState
-	stateStore             map[string]map[string]AsyncState
+	processStore             map[string]Process
+	persistenceSchemaStore   map[string]PersistenceSchema
+	localAttrKeys            map[string]map[string]bool
+	startingState            map[string]AsyncState
+	stateStore               map[string]map[string]AsyncState
+	stateFactoryStore        map[string]map[string]AsyncStateFactory
+	communicationSchemaStore map[string]CommunicationSchema
 }
 
 func (r *registryImpl) AddProcess(processDef Process) error {
@@ -18,6 +22,7 @@ func (r *registryImpl) AddProcess(processDef Process) error {
 	if err := r.registerPersistenceSchema(processDef); err != nil {
 		return err
 	}
+	r.registerCommunicationSchema(processDef)
 	return nil
 }
 
@@ -51,6 +56,16 @@ func (r *registryImpl) getProcessState(prcType string, stateId string) AsyncStat
 	return r.stateStore[prcType][stateId]
 }
 
+// newProcessStateInstance returns a new instance of the state if it's registered with a factory,
+// otherwise the registered instance
+func (r *registryImpl) newProcessStateInstance(prcType string, stateId string) AsyncState {
+	factory, ok := r.stateFactoryStore[prcType][stateId]
+	if ok {
+		return factory()
+	}
+	return r.getProcessState(prcType, stateId)
+}
+
 func (r *registryImpl) getPersistenceSchema(prcType string) PersistenceSchema {
 	return r.persistenceSchemaStore[prcType]
 }
@@ -67,18 +82,38 @@ func (r *registryImpl) registerProcessType(prc Process) error {
 
 func (r *registryImpl) registerProcessState(prc Process) error {
 	prcType := GetFinalProcessType(prc)
+	stateSchema := prc.GetAsyncStateSchema()
 	stateMap := map[string]AsyncState{}
-	for _, state := range prc.GetAsyncStateSchema().AllStates {
+	factoryMap := map[string]AsyncStateFactory{}
+	for _, state := range stateSchema.AllStates {
+		stateId := GetFinalStateId(state)
+		_, ok := stateMap[stateId]
+		if ok {
+			return NewProcessDefinitionError("Process %v cannot have duplicate stateId %v ", prcType, stateId)
+		}
+		stateMap[stateId] = state
+	}
+	for _, factory := range stateSchema.AllStateFactories {
+		// the instance is only used for the stateId and options of the state
+		state := factory()
 		stateId := GetFinalStateId(state)
 		_, ok := stateMap[stateId]
 		if ok {
 			return NewProcessDefinitionError("Process %v cannot have duplicate stateId %v ", prcType, stateId)
 		}
 		stateMap[stateId] = state
+		factoryMap[stateId] = factory
 	}
 	r.stateStore[prcType] = stateMap
-	if prc.GetAsyncStateSchema().StartingState != nil {
-		r.startingState[prcType] = prc.GetAsyncStateSchema().StartingState
+	r.stateFactoryStore[prcType] = factoryMap
+	if stateSchema.StartingState != nil && stateSchema.StartingStateFactory != nil {
+		return NewProcessDefinitionError("Process %v cannot have both StartingState and StartingStateFactory", prcType)
+	}
+	if stateSchema.StartingState != nil {
+		r.startingState[prcType] = stateSchema.StartingState
+	}
+	if stateSchema.StartingStateFactory != nil {
+		r.startingState[prcType] = stateMap[GetFinalStateId(stateSchema.StartingStateFactory())]
 	}
 
 	return nil
@@ -101,3 +136,96 @@ func (r *registryImpl) registerPersistenceSchema(prc Process) error {
 func (r *registryImpl) getLocalAttributeKeys(prcType string) map[string]bool {
 	return r.localAttrKeys[prcType]
 }
+
+func (r *registryImpl) registerCommunicationSchema(prc Process) {
+	prcType := GetFinalProcessType(prc)
+	r.communicationSchemaStore[prcType] = prc.GetCommunicationSchema()
+}
+
+func (r *registryImpl) getCommunicationSchema(prcType string) CommunicationSchema {
+	return r.communicationSchemaStore[prcType]
+}
+
+func (r *registryImpl) isAnyLocalQueueDeclared() bool {
+	for _, schema := range r.communicationSchemaStore {
+		if schema.IsLocalQueueDeclared() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *registryImpl) GetRegistryInfo() RegistryInfo {
+	prcTypes := r.GetAllRegisteredProcessTypes()
+	sort.Strings(prcTypes)
+
+	info := RegistryInfo{
+		Processes: []ProcessInfo{},
+	}
+	for _, prcType := range prcTypes {
+		info.Processes = append(info.Processes, r.getProcessInfo(prcType))
+	}
+	return info
+}
+
+func (r *registryImpl) getProcessInfo(prcType string) ProcessInfo {
+	prcOptions := r.getProcess(prcType).GetProcessOptions()
+	info := ProcessInfo{
+		ProcessType:    prcType,
+		TimeoutSeconds: prcOptions.TimeoutSeconds,
+		IdReusePolicy:  prcOptions.IdReusePolicy,
+		States:         []StateInfo{},
+	}
+
+	if startingState := r.getProcessStartingState(prcType); startingState != nil {
+		startingStateId := GetFinalStateId(startingState)
+		info.StartingStateId = &startingStateId
+	}
+
+	var stateIds []string
+	for stateId := range r.stateStore[prcType] {
+		stateIds = append(stateIds, stateId)
+	}
+	sort.Strings(stateIds)
+	for _, stateId := range stateIds {
+		state := r.getProcessState(prcType, stateId)
+		info.States = append(info.States, StateInfo{
+			StateId:       stateId,
+			SkipWaitUntil: ShouldSkipWaitUntilAPI(state),
+			Config:        fromStateToAsyncStateConfig(state, prcType, r),
+		})
+	}
+
+	if localAttrSchema := r.getPersistenceSchema(prcType).LocalAttributeSchema; localAttrSchema != nil {
+		policy := localAttrSchema.DefaultLocalAttributePolicy
+		info.LocalAttributes = &LocalAttributesInfo{
+			Keys:         getSortedKeys(localAttrSchema.LocalAttributeKeys),
+			KeysNoLock:   getSortedKeys(policy.LocalAttributeKeysNoLock),
+			KeysWithLock: getSortedKeys(policy.LocalAttributeKeysWithLock),
+			LockingType:  policy.LockingType,
+		}
+	}
+
+	commSchema := r.getCommunicationSchema(prcType)
+	for _, queueName := range getSortedKeys(commSchema.LocalQueues) {
+		def := commSchema.LocalQueues[queueName]
+		queueInfo := LocalQueueInfo{
+			QueueName: queueName,
+			MaxCount:  def.MaxCount,
+		}
+		if def.PayloadType != nil {
+			queueInfo.PayloadType = def.PayloadType.String()
+		}
+		info.LocalQueues = append(info.LocalQueues, queueInfo)
+	}
+	return info
+}
+
+func getSortedKeys[V any](m map[string]V) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,38 @@
+package xc
+
+import "github.com/xcherryio/apis/goapi/xcapi"
+
+// RegistryInfo describes what a Registry serves, e.g. for verifying a worker registers the expected processes
+type RegistryInfo struct {
+	Processes []ProcessInfo `json:"processes"`
+}
+
+type ProcessInfo struct {
+	ProcessType     string                      `json:"processType"`
+	StartingStateId *string                     `json:"startingStateId,omitempty"`
+	TimeoutSeconds  int32                       `json:"timeoutSeconds"`
+	IdReusePolicy   *xcapi.ProcessIdReusePolicy `json:"idReusePolicy,omitempty"`
+	States          []StateInfo                 `json:"states"`
+	LocalAttributes *LocalAttributesInfo        `json:"localAttributes,omitempty"`
+	LocalQueues     []LocalQueueInfo            `json:"localQueues,omitempty"`
+}
+
+type StateInfo struct {
+	StateId       string `json:"stateId"`
+	SkipWaitUntil bool   `json:"skipWaitUntil"`
+	// Config includes the timeouts, retry policies, failure recovery and persistence loading of the state
+	Config *xcapi.AsyncStateConfig `json:"config,omitempty"`
+}
+
+type LocalAttributesInfo struct {
+	Keys         []string        `json:"keys"`
+	KeysNoLock   []string        `json:"keysNoLock,omitempty"`
+	KeysWithLock []string        `json:"keysWithLock,omitempty"`
+	LockingType  *xcapi.LockType `json:"lockingType,omitempty"`
+}
+
+type LocalQueueInfo struct {
+	QueueName   string `json:"queueName"`
+	PayloadType string `json:"payloadType,omitempty"`
+	MaxCount    int    `json:"maxCount,omitempty"`
+}
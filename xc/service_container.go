@@ -0,0 +1,60 @@
+package xc
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ServiceContainer holds the services, like DB handles, HTTP clients or the xc.Client,
+// so that the states can look them up by type from the Context instead of package globals.
+// The services are registered when setting up the worker, see WorkerOptions.Services,
+// and can be swapped with fakes in testing.
+type ServiceContainer struct {
+	lock     sync.RWMutex
+	services map[reflect.Type]interface{}
+}
+
+func NewServiceContainer() *ServiceContainer {
+	return &ServiceContainer{
+		services: map[reflect.Type]interface{}{},
+	}
+}
+
+// RegisterService registers the service by the type T, which is usually an interface,
+// e.g. RegisterService[*sql.DB](container, db) or RegisterService[xc.Client](container, client)
+// It replaces the service previously registered with the same type
+func RegisterService[T any](container *ServiceContainer, service T) {
+	container.lock.Lock()
+	defer container.lock.Unlock()
+	container.services[getServiceType[T]()] = service
+}
+
+// LookupService returns the service of type T from the Context, false if not registered
+func LookupService[T any](ctx Context) (T, bool) {
+	var empty T
+	container := ctx.GetServices()
+	if container == nil {
+		return empty, false
+	}
+	container.lock.RLock()
+	defer container.lock.RUnlock()
+	svc, ok := container.services[getServiceType[T]()]
+	if !ok {
+		return empty, false
+	}
+	return svc.(T), true
+}
+
+// GetService returns the service of type T from the Context, panics if not registered
+func GetService[T any](ctx Context) T {
+	svc, ok := LookupService[T](ctx)
+	if !ok {
+		panic(fmt.Sprintf("service %v is not registered in the ServiceContainer", getServiceType[T]()))
+	}
+	return svc
+}
+
+func getServiceType[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
@@ -0,0 +1,34 @@
+package xc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+type testGreeter interface {
+	Greet() string
+}
+
+type fakeGreeter struct{}
+
+func (f fakeGreeter) Greet() string {
+	return "fake"
+}
+
+func TestServiceContainer(t *testing.T) {
+	services := NewServiceContainer()
+	RegisterService[testGreeter](services, fakeGreeter{})
+	RegisterService(services, 123)
+
+	ctx := NewTestContext(xcapi.Context{}, services)
+	assert.Equal(t, "fake", GetService[testGreeter](ctx).Greet())
+	assert.Equal(t, 123, GetService[int](ctx))
+
+	_, ok := LookupService[string](ctx)
+	assert.False(t, ok)
+	assert.Panics(t, func() {
+		GetService[string](ctx)
+	})
+}
@@ -5,6 +5,9 @@ import "github.com/xcherryio/apis/goapi/xcapi"
 type StateDecision struct {
 	NextStates      []StateMovement
 	ThreadCloseType *xcapi.ThreadCloseType
+	// CloseResult is the optional result of closing the process, encoded by the ObjectEncoder of the worker
+	// See CompleteProcessWithResult
+	CloseResult interface{}
 }
 
 func SingleNextState(state AsyncState, input interface{}) *StateDecision {
@@ -51,3 +54,27 @@ var DeadEnd = &StateDecision{
 var ForceFailProcess = &StateDecision{
 	ThreadCloseType: xcapi.FORCE_FAIL_PROCESS.Ptr(),
 }
+
+// CompleteProcessWithResult is GracefulCompletingProcess with the result of the process
+func CompleteProcessWithResult(result interface{}) *StateDecision {
+	return &StateDecision{
+		ThreadCloseType: xcapi.GRACEFUL_COMPLETE_PROCESS.Ptr(),
+		CloseResult:     result,
+	}
+}
+
+// ForceCompleteProcessWithResult is ForceCompletingProcess with the result of the process
+func ForceCompleteProcessWithResult(result interface{}) *StateDecision {
+	return &StateDecision{
+		ThreadCloseType: xcapi.FORCE_COMPLETE_PROCESS.Ptr(),
+		CloseResult:     result,
+	}
+}
+
+// ForceFailProcessWithResult is ForceFailProcess with the result of the process, e.g. the failure details
+func ForceFailProcessWithResult(result interface{}) *StateDecision {
+	return &StateDecision{
+		ThreadCloseType: xcapi.FORCE_FAIL_PROCESS.Ptr(),
+		CloseResult:     result,
+	}
+}
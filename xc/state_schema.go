@@ -1,8 +1,17 @@
 package xc
 
+// AsyncStateFactory creates a new instance of an AsyncState.
+// It's used when a state has mutable fields or per-invocation dependencies, so that
+// each WaitUntil/Execute invocation gets a fresh instance instead of sharing the registered one.
+type AsyncStateFactory func() AsyncState
+
 type StateSchema struct {
 	StartingState AsyncState
 	AllStates     []AsyncState
+	// StartingStateFactory is the factory of the starting state, can be used instead of StartingState
+	StartingStateFactory AsyncStateFactory
+	// AllStateFactories are the factories of the states, the states created by them are registered along with AllStates
+	AllStateFactories []AsyncStateFactory
 }
 
 func NewStateSchema(startingState AsyncState, nonStartingStates ...AsyncState) StateSchema {
@@ -19,3 +28,24 @@ func NewStateSchemaNoStartingState(nonStartingStates ...AsyncState) StateSchema
 		AllStates: nonStartingStates,
 	}
 }
+
+// NewStateSchemaWithFactories is the same as NewStateSchema, but with the factories of the states
+// so that every WaitUntil/Execute invocation gets a new state instance
+func NewStateSchemaWithFactories(
+	startingState AsyncStateFactory, nonStartingStates ...AsyncStateFactory,
+) StateSchema {
+	allStates := nonStartingStates
+	allStates = append(allStates, startingState)
+	return StateSchema{
+		StartingStateFactory: startingState,
+		AllStateFactories:    allStates,
+	}
+}
+
+// NewStateSchemaWithFactoriesNoStartingState is the same as NewStateSchemaNoStartingState,
+// but with the factories of the states
+func NewStateSchemaWithFactoriesNoStartingState(nonStartingStates ...AsyncStateFactory) StateSchema {
+	return StateSchema{
+		AllStateFactories: nonStartingStates,
+	}
+}
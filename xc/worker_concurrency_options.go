@@ -0,0 +1,35 @@
+package xc
+
+import "time"
+
+// WorkerConcurrencyOptions limits the concurrent WaitUntil/Execute invocations in WorkerService,
+// so that a burst of requests(e.g. timers firing at the same time) doesn't exhaust the resources like DB connections.
+// A request waits in the queue when the limit is reached, and is rejected with WorkerOverloadedError
+// when the queue is full, or it has waited for MaxQueueingDuration.
+type WorkerConcurrencyOptions struct {
+	// MaxConcurrentRequests is the max number of concurrent invocations in total
+	// Default: 0, means no limit
+	MaxConcurrentRequests int
+	// MaxConcurrentRequestsPerProcessType is the max number of concurrent invocations of a process type
+	// key is the process type, missing means no limit
+	MaxConcurrentRequestsPerProcessType map[string]int
+	// MaxConcurrentRequestsPerState is the max number of concurrent invocations of a state
+	// key is GetWorkerConcurrencyStateKey(processType, stateId), missing means no limit
+	MaxConcurrentRequestsPerState map[string]int
+	// MaxQueuedRequests is the max number of requests waiting for the limits
+	// Default: 0, means rejecting immediately when the limit is reached
+	MaxQueuedRequests int
+	// MaxQueueingDuration is the max duration for a request to wait for the limits
+	// Default: 0, means waiting until the request is cancelled
+	MaxQueueingDuration time.Duration
+	// RetryAfter is the hint for server to retry the rejected requests, e.g. the Retry-After header of HTTP 429
+	// Default: DefaultWorkerOverloadedRetryAfter when set as 0
+	RetryAfter time.Duration
+}
+
+const DefaultWorkerOverloadedRetryAfter = time.Second
+
+// GetWorkerConcurrencyStateKey returns the key of MaxConcurrentRequestsPerState for the state of the process type
+func GetWorkerConcurrencyStateKey(prcType, stateId string) string {
+	return prcType + "/" + stateId
+}
@@ -1,11 +1,29 @@
 package xc
 
+import "time"
+
 type WorkerOptions struct {
+	// Namespace is the namespace of the processes that the worker serves, which is returned by Context.GetNamespace
+	// Default: DefaultNamespace when set as empty string
+	Namespace     string
 	ObjectEncoder ObjectEncoder
+	// Services is the container of the services for states to look up with GetService from the Context
+	// Default: an empty container when set as nil
+	Services *ServiceContainer
+	// ConcurrencyOptions limits the concurrent WaitUntil/Execute invocations
+	// Default: no limit when set as nil
+	ConcurrencyOptions *WorkerConcurrencyOptions
+	// DefaultStateApiTimeout is the deadline of the Context when the state doesn't set
+	// WaitUntilTimeoutSeconds/ExecuteTimeoutSeconds in AsyncStateOptions.
+	// It should be the same as the default state API timeout configured in server.
+	// Default: no deadline when set as 0, the Context is still cancelled when the request from server is aborted
+	DefaultStateApiTimeout time.Duration
 }
 
 func GetDefaultWorkerOptions() WorkerOptions {
 	return WorkerOptions{
+		Namespace:     DefaultNamespace,
 		ObjectEncoder: GetDefaultObjectEncoder(),
+		Services:      NewServiceContainer(),
 	}
 }
@@ -9,21 +9,44 @@ import (
 const (
 	ApiPathAsyncStateWaitUntil = "/api/v1/xcherry/worker/async-state/wait-until"
 	ApiPathAsyncStateExecute   = "/api/v1/xcherry/worker/async-state/execute"
+
+	// ApiPathHealth is for the liveness probe, see WorkerService.IsHealthy
+	ApiPathHealth = "/api/v1/xcherry/worker/health"
+	// ApiPathReady is for the readiness probe, see WorkerService.IsReady
+	ApiPathReady = "/api/v1/xcherry/worker/ready"
+	// ApiPathRegistryInfo is for listing the registered processes, see WorkerService.GetRegistryInfo
+	ApiPathRegistryInfo = "/api/v1/xcherry/worker/registry"
 )
 
 // WorkerService is for worker to handle task requests from xCherry server
 // Typically put it behind a REST controller, using the above API paths
+// The ctx should be the context of the request, so that the Context of the state API is cancelled
+// when the request is aborted
 type WorkerService interface {
 	HandleAsyncStateWaitUntil(ctx context.Context, request xcapi.AsyncStateWaitUntilRequest) (*xcapi.AsyncStateWaitUntilResponse, error)
 	HandleAsyncStateExecute(ctx context.Context, request xcapi.AsyncStateExecuteRequest) (*xcapi.AsyncStateExecuteResponse, error)
+	// IsHealthy returns true if the worker is alive to handle requests
+	IsHealthy() bool
+	// IsReady returns true if the worker is ready to handle requests, which means at least one process is registered
+	IsReady() bool
+	// GetRegistryInfo returns the info of the registered processes that the worker serves
+	GetRegistryInfo() RegistryInfo
 }
 
 func NewWorkerService(registry Registry, options *WorkerOptions) WorkerService {
 	if options == nil {
 		options = ptr.Any(GetDefaultWorkerOptions())
 	}
+	workerOptions := *options
+	if workerOptions.Namespace == "" {
+		workerOptions.Namespace = DefaultNamespace
+	}
+	if workerOptions.Services == nil {
+		workerOptions.Services = NewServiceContainer()
+	}
 	return &workerServiceImpl{
 		registry: registry,
-		options:  *options,
+		options:  workerOptions,
+		limiter:  newConcurrencyLimiter(workerOptions.ConcurrencyOptions),
 	}
 }
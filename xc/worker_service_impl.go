@@ -2,6 +2,7 @@ package xc
 
 import (
 	"context"
+	"time"
 
 	"github.com/xcherryio/apis/goapi/xcapi"
 )
@@ -9,33 +10,72 @@ import (
 type workerServiceImpl struct {
 	registry Registry
 	options  WorkerOptions
+	limiter  *concurrencyLimiter
 }
 
 func (w *workerServiceImpl) HandleAsyncStateWaitUntil(
 	ctx context.Context, request xcapi.AsyncStateWaitUntilRequest,
 ) (resp *xcapi.AsyncStateWaitUntilResponse, retErr error) {
+	release, err := w.limiter.acquire(ctx, request.GetProcessType(), request.GetStateId())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	defer func() { captureStateExecutionError(recover(), &retErr) }()
 
+	// waiting again for the rest of the commands of the CommandRequest, without invoking the WaitUntil
+	waiting, ok, err := decodeCommandWaiting(request.StateInput)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		idlCommandRequest, err := waiting.getApiCommandRequest(time.Now())
+		if err != nil {
+			return nil, err
+		}
+		return &xcapi.AsyncStateWaitUntilResponse{
+			CommandRequest: *idlCommandRequest,
+		}, nil
+	}
+
 	prcType := request.GetProcessType()
-	stateDef := w.registry.getProcessState(prcType, request.GetStateId())
-	input := NewObject(request.StateInput, w.options.ObjectEncoder)
+	stateDef := w.registry.newProcessStateInstance(prcType, request.GetStateId())
 	reqContext := request.GetContext()
-	wfCtx := newContext(reqContext)
-
-	comm := NewCommunication(w.options.ObjectEncoder)
+	encoder := GetObjectEncoderForProcess(w.options.ObjectEncoder, reqContext.GetProcessId())
+	input := NewObject(request.StateInput, encoder)
+	goCtx, cancel := withStateApiTimeout(
+		ctx, getStateApiTimeout(stateDef, xcapi.WAIT_UNTIL_API, w.options.DefaultStateApiTimeout))
+	defer cancel()
+	wfCtx := newContext(
+		goCtx, reqContext, prcType, request.GetStateId(), w.options.Namespace, w.options.Services)
+
+	commSchema := w.registry.getCommunicationSchema(prcType)
+	comm := NewCommunicationWithSchema(encoder, commSchema)
 	commandRequest, err := stateDef.WaitUntil(wfCtx, input, comm)
 
 	if err != nil {
 		return nil, err
 	}
 
-	idlCommandRequest, err := toApiCommandRequest(commandRequest)
-	if err != nil {
-		return nil, err
+	publishToLocalQueue := comm.GetLocalQueueMessagesToPublish()
+	var idlCommandRequest *xcapi.CommandRequest
+	if needsCommandWaiting(commandRequest) {
+		var msg *xcapi.LocalQueueMessage
+		idlCommandRequest, msg, err = startCommandWaiting(
+			commandRequest, commSchema, reqContext.GetStateExecutionId(), time.Now())
+		if err != nil {
+			return nil, err
+		}
+		publishToLocalQueue = append(publishToLocalQueue, *msg)
+	} else {
+		idlCommandRequest, err = toApiCommandRequest(commandRequest, commSchema)
+		if err != nil {
+			return nil, err
+		}
 	}
 	resp = &xcapi.AsyncStateWaitUntilResponse{
 		CommandRequest:      *idlCommandRequest,
-		PublishToLocalQueue: comm.GetLocalQueueMessagesToPublish(),
+		PublishToLocalQueue: publishToLocalQueue,
 	}
 
 	return resp, nil
@@ -44,31 +84,70 @@ func (w *workerServiceImpl) HandleAsyncStateWaitUntil(
 func (w *workerServiceImpl) HandleAsyncStateExecute(
 	ctx context.Context, request xcapi.AsyncStateExecuteRequest,
 ) (resp *xcapi.AsyncStateExecuteResponse, retErr error) {
+	release, err := w.limiter.acquire(ctx, request.GetProcessType(), request.GetStateId())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	defer func() { captureStateExecutionError(recover(), &retErr) }()
 
 	prcType := request.GetProcessType()
-	stateDef := w.registry.getProcessState(prcType, request.GetStateId())
-	input := NewObject(request.StateInput, w.options.ObjectEncoder)
+	stateDef := w.registry.newProcessStateInstance(prcType, request.GetStateId())
 	reqContext := request.GetContext()
-	wfCtx := newContext(reqContext)
-
-	commandResults, err := fromApiCommandResults(request.CommandResults, w.options.ObjectEncoder)
+	encoder := GetObjectEncoderForProcess(w.options.ObjectEncoder, reqContext.GetProcessId())
+	stateInput := request.StateInput
+	var commandResults CommandResults
+	waiting, ok, err := getCommandWaiting(request.StateInput, request.CommandResults, reqContext.GetStateExecutionId())
 	if err != nil {
 		return nil, err
 	}
+	if ok {
+		commandResults = waiting.getCommandResults(encoder)
+		if !waiting.Request.IsSatisfied(commandResults) {
+			idlDecision, err := waiting.getWaitingAgainDecision(stateDef, prcType, w.registry)
+			if err != nil {
+				return nil, err
+			}
+			return &xcapi.AsyncStateExecuteResponse{
+				StateDecision: *idlDecision,
+			}, nil
+		}
+		stateInput = waiting.Input
+	} else {
+		commandResults, err = fromApiCommandResults(request.CommandResults, encoder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	input := NewObject(stateInput, encoder)
+	goCtx, cancel := withStateApiTimeout(
+		ctx, getStateApiTimeout(stateDef, xcapi.EXECUTE_API, w.options.DefaultStateApiTimeout))
+	defer cancel()
+	wfCtx := newContext(
+		goCtx, reqContext, prcType, request.GetStateId(), w.options.Namespace, w.options.Services)
 
-	pers := w.createPersistenceImpl(prcType, request.LoadedLocalAttributes)
+	pers, err := w.createPersistenceImpl(prcType, request.LoadedLocalAttributes, encoder)
+	if err != nil {
+		return nil, err
+	}
 
-	comm := NewCommunication(w.options.ObjectEncoder)
+	comm := NewCommunicationWithSchema(encoder, w.registry.getCommunicationSchema(prcType))
 	decision, err := stateDef.Execute(wfCtx, input, commandResults, pers, comm)
 
 	if err != nil {
 		return nil, err
 	}
-	idlDecision, err := toApiDecision(decision, prcType, w.registry, w.options.ObjectEncoder)
+	idlDecision, err := toApiDecision(decision, prcType, w.registry, encoder)
 	if err != nil {
 		return nil, err
 	}
+	if closeDecision := idlDecision.ThreadCloseDecision; closeDecision != nil &&
+		closeDecision.CloseType != xcapi.DEAD_END {
+		if err := onProcessClosing(goCtx, w.options.ObjectEncoder, reqContext.GetProcessId()); err != nil {
+			return nil, err
+		}
+	}
 	resp = &xcapi.AsyncStateExecuteResponse{
 		StateDecision:       *idlDecision,
 		PublishToLocalQueue: comm.GetLocalQueueMessagesToPublish(),
@@ -79,11 +158,24 @@ func (w *workerServiceImpl) HandleAsyncStateExecute(
 	return resp, nil
 }
 
+func (w *workerServiceImpl) IsHealthy() bool {
+	return true
+}
+
+func (w *workerServiceImpl) IsReady() bool {
+	return len(w.registry.GetAllRegisteredProcessTypes()) > 0
+}
+
+func (w *workerServiceImpl) GetRegistryInfo() RegistryInfo {
+	return w.registry.GetRegistryInfo()
+}
+
 func (w *workerServiceImpl) createPersistenceImpl(
-	prcType string, currLocalAttrs *xcapi.LoadLocalAttributesResponse,
-) Persistence {
-	localAttributeKeys := w.registry.getLocalAttributeKeys(prcType)
-	return NewPersistenceImpl(
-		localAttributeKeys,
-		currLocalAttrs)
+	prcType string, currLocalAttrs *xcapi.LoadLocalAttributesResponse, encoder ObjectEncoder,
+) (Persistence, error) {
+	return newPersistenceImpl(
+		w.registry.getPersistenceSchema(prcType).LocalAttributeSchema,
+		currLocalAttrs,
+		encoder,
+		time.Now)
 }
@@ -0,0 +1,221 @@
+package xc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+type statefulTestProcess struct {
+	ProcessDefaults
+}
+
+func (p statefulTestProcess) GetAsyncStateSchema() StateSchema {
+	return NewStateSchemaWithFactories(func() AsyncState {
+		return &statefulTestState{}
+	})
+}
+
+type statefulTestState struct {
+	AsyncStateDefaultsSkipWaitUntil
+	executedCount int
+}
+
+func (s *statefulTestState) Execute(
+	ctx Context, input Object, commandResults CommandResults, persistence Persistence, communication Communication,
+) (*StateDecision, error) {
+	s.executedCount++
+	if s.executedCount != 1 {
+		return nil, fmt.Errorf("state instance is shared, executedCount: %v", s.executedCount)
+	}
+	return DeadEnd, nil
+}
+
+func TestStateFactoryCreatesInstancePerExecution(t *testing.T) {
+	registry := NewRegistry()
+	assert.Nil(t, registry.AddProcess(statefulTestProcess{}))
+	workerService := NewWorkerService(registry, nil)
+
+	prcType := GetFinalProcessType(statefulTestProcess{})
+	stateId := GetFinalStateId(&statefulTestState{})
+	assert.Equal(t, stateId, GetFinalStateId(registry.getProcessStartingState(prcType)))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := workerService.HandleAsyncStateExecute(context.Background(), xcapi.AsyncStateExecuteRequest{
+				Context: xcapi.Context{
+					ProcessId: fmt.Sprintf("process-%v", i),
+					Attempt:   xcapi.PtrInt32(1),
+				},
+				ProcessType: prcType,
+				StateId:     stateId,
+			})
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.Nil(t, err)
+	}
+}
+
+func TestWorkerServiceReadinessAndRegistryInfo(t *testing.T) {
+	registry := NewRegistry()
+	workerService := NewWorkerService(registry, nil)
+	assert.True(t, workerService.IsHealthy())
+	assert.False(t, workerService.IsReady())
+	assert.Empty(t, workerService.GetRegistryInfo().Processes)
+
+	assert.Nil(t, registry.AddProcess(statefulTestProcess{}))
+	assert.True(t, workerService.IsReady())
+
+	stateId := GetFinalStateId(&statefulTestState{})
+	info := workerService.GetRegistryInfo()
+	assert.Equal(t, 1, len(info.Processes))
+	prcInfo := info.Processes[0]
+	assert.Equal(t, GetFinalProcessType(statefulTestProcess{}), prcInfo.ProcessType)
+	assert.Equal(t, &stateId, prcInfo.StartingStateId)
+	assert.Equal(t, 1, len(prcInfo.States))
+	assert.Equal(t, stateId, prcInfo.States[0].StateId)
+	assert.True(t, prcInfo.States[0].SkipWaitUntil)
+	assert.NotNil(t, prcInfo.States[0].Config)
+}
+
+type contextTestProcess struct {
+	ProcessDefaults
+}
+
+func (p contextTestProcess) GetAsyncStateSchema() StateSchema {
+	return NewStateSchema(&contextTestState{}, &contextTimeoutTestState{})
+}
+
+// contextTestState sends the Context of the WaitUntil and the Execute, and blocks the Execute until it's cancelled
+type contextTestState struct {
+	AsyncStateDefaults
+	contexts chan Context
+}
+
+func (s *contextTestState) WaitUntil(ctx Context, input Object, communication Communication) (*CommandRequest, error) {
+	s.contexts <- ctx
+	return EmptyCommandRequest(), nil
+}
+
+func (s *contextTestState) Execute(
+	ctx Context, input Object, commandResults CommandResults, persistence Persistence, communication Communication,
+) (*StateDecision, error) {
+	s.contexts <- ctx
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+type contextTimeoutTestState struct {
+	contextTestState
+}
+
+func (s *contextTimeoutTestState) GetStateOptions() *AsyncStateOptions {
+	return &AsyncStateOptions{
+		WaitUntilTimeoutSeconds: 5,
+	}
+}
+
+func TestStateApiContext(t *testing.T) {
+	contexts := make(chan Context, 1)
+	registry := NewRegistry()
+	assert.Nil(t, registry.AddProcess(contextTestProcess{}))
+	prcType := GetFinalProcessType(contextTestProcess{})
+	registry.getProcessState(prcType, GetFinalStateId(&contextTestState{})).(*contextTestState).contexts = contexts
+	registry.getProcessState(prcType, GetFinalStateId(&contextTimeoutTestState{})).(*contextTimeoutTestState).
+		contexts = contexts
+	options := GetDefaultWorkerOptions()
+	options.Namespace = "ns"
+	workerService := NewWorkerService(registry, &options)
+	apiCtx := xcapi.Context{
+		ProcessId:               "process-1",
+		ProcessExecutionId:      "execution-1",
+		StateExecutionId:        xcapi.PtrString("state1-1"),
+		ProcessStartedTimestamp: 1700000000,
+		FirstAttemptTimestamp:   xcapi.PtrInt64(1700000010),
+		Attempt:                 xcapi.PtrInt32(2),
+	}
+	waitUntil := func(stateId string) Context {
+		_, err := workerService.HandleAsyncStateWaitUntil(context.Background(), xcapi.AsyncStateWaitUntilRequest{
+			Context:     apiCtx,
+			ProcessType: prcType,
+			StateId:     stateId,
+		})
+		assert.Nil(t, err)
+		return <-contexts
+	}
+
+	ctx := waitUntil(GetFinalStateId(&contextTestState{}))
+	assert.Equal(t, "process-1", ctx.GetProcessId())
+	assert.Equal(t, "execution-1", ctx.GetProcessExecutionId())
+	assert.Equal(t, prcType, ctx.GetProcessType())
+	assert.Equal(t, "ns", ctx.GetNamespace())
+	assert.Equal(t, GetFinalStateId(&contextTestState{}), ctx.GetStateId())
+	assert.Equal(t, "state1-1", ctx.GetStateExecutionId())
+	assert.Equal(t, time.Unix(1700000000, 0), ctx.GetProcessStartTime())
+	assert.Equal(t, time.Unix(1700000010, 0), ctx.GetFirstAttemptStartTime())
+	assert.Equal(t, 2, ctx.GetAttempt())
+	// no deadline without the timeout in AsyncStateOptions or WorkerOptions, and cancelled after the API returns
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+	assert.Equal(t, context.Canceled, ctx.Err())
+
+	ctx = waitUntil(GetFinalStateId(&contextTimeoutTestState{}))
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.InDelta(t, time.Now().Add(5*time.Second).Unix(), deadline.Unix(), 1)
+
+	options.DefaultStateApiTimeout = time.Minute
+	workerService = NewWorkerService(registry, &options)
+	ctx = waitUntil(GetFinalStateId(&contextTestState{}))
+	deadline, ok = ctx.Deadline()
+	assert.True(t, ok)
+	assert.InDelta(t, time.Now().Add(time.Minute).Unix(), deadline.Unix(), 1)
+	// the state API timeout in AsyncStateOptions takes precedence
+	ctx = waitUntil(GetFinalStateId(&contextTimeoutTestState{}))
+	deadline, _ = ctx.Deadline()
+	assert.InDelta(t, time.Now().Add(5*time.Second).Unix(), deadline.Unix(), 1)
+
+	// the Execute is cancelled when the request from server is aborted
+	reqCtx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := workerService.HandleAsyncStateExecute(reqCtx, xcapi.AsyncStateExecuteRequest{
+			Context:     apiCtx,
+			ProcessType: prcType,
+			StateId:     GetFinalStateId(&contextTestState{}),
+		})
+		errs <- err
+	}()
+	<-contexts
+	cancel()
+	select {
+	case err := <-errs:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "the Execute is not cancelled")
+	}
+}
+
+func TestNewTestContext(t *testing.T) {
+	ctx := NewTestContext(xcapi.Context{
+		ProcessId:          "process-1",
+		ProcessExecutionId: "execution-1",
+	}, nil)
+	assert.Equal(t, DefaultNamespace, ctx.GetNamespace())
+	assert.Equal(t, "process-1", ctx.GetProcessId())
+	assert.True(t, ctx.GetFirstAttemptStartTime().IsZero())
+	assert.Nil(t, ctx.Err())
+}
@@ -0,0 +1,90 @@
+package xc
+
+import (
+	"context"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc/ptr"
+)
+
+// WorkerServiceRouter hosts the WorkerServices of multiple namespaces in one worker,
+// so that the processes of different namespaces can use the same process types.
+// The requests of a namespace are served on the API paths prefixed by the namespace, see GetNamespacedApiPath.
+// Processes must be started with the namespaced worker url, see ClientOptions.EnableNamespacedWorkerUrl
+type WorkerServiceRouter interface {
+	// HandleAsyncStateWaitUntil routes the request to the WorkerService of the namespace
+	HandleAsyncStateWaitUntil(
+		ctx context.Context, namespace string, request xcapi.AsyncStateWaitUntilRequest,
+	) (*xcapi.AsyncStateWaitUntilResponse, error)
+	// HandleAsyncStateExecute routes the request to the WorkerService of the namespace
+	HandleAsyncStateExecute(
+		ctx context.Context, namespace string, request xcapi.AsyncStateExecuteRequest,
+	) (*xcapi.AsyncStateExecuteResponse, error)
+	// GetWorkerService returns the WorkerService of the namespace
+	GetWorkerService(namespace string) (WorkerService, bool)
+	// GetNamespaces returns all the namespaces, sorted
+	GetNamespaces() []string
+}
+
+// NewWorkerServiceRouter returns a WorkerServiceRouter
+// registries is the namespace to the registry of the processes in the namespace
+// options is shared by the WorkerServices, except that the Namespace is overridden,
+// and the ConcurrencyOptions are applied to each namespace separately
+func NewWorkerServiceRouter(registries map[string]Registry, options *WorkerOptions) WorkerServiceRouter {
+	if options == nil {
+		options = ptr.Any(GetDefaultWorkerOptions())
+	}
+	services := map[string]WorkerService{}
+	for namespace, registry := range registries {
+		if namespace == "" {
+			panic("namespace cannot be empty")
+		}
+		namespaceOptions := *options
+		namespaceOptions.Namespace = namespace
+		services[namespace] = NewWorkerService(registry, &namespaceOptions)
+	}
+	return &workerServiceRouterImpl{
+		services: services,
+	}
+}
+
+type workerServiceRouterImpl struct {
+	services map[string]WorkerService
+}
+
+func (r *workerServiceRouterImpl) HandleAsyncStateWaitUntil(
+	ctx context.Context, namespace string, request xcapi.AsyncStateWaitUntilRequest,
+) (*xcapi.AsyncStateWaitUntilResponse, error) {
+	svc, err := r.route(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return svc.HandleAsyncStateWaitUntil(ctx, request)
+}
+
+func (r *workerServiceRouterImpl) HandleAsyncStateExecute(
+	ctx context.Context, namespace string, request xcapi.AsyncStateExecuteRequest,
+) (*xcapi.AsyncStateExecuteResponse, error) {
+	svc, err := r.route(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return svc.HandleAsyncStateExecute(ctx, request)
+}
+
+func (r *workerServiceRouterImpl) GetWorkerService(namespace string) (WorkerService, bool) {
+	svc, ok := r.services[namespace]
+	return svc, ok
+}
+
+func (r *workerServiceRouterImpl) GetNamespaces() []string {
+	return getSortedKeys(r.services)
+}
+
+func (r *workerServiceRouterImpl) route(namespace string) (WorkerService, error) {
+	svc, ok := r.services[namespace]
+	if !ok {
+		return nil, NewInvalidArgumentError("namespace is not served by the worker: %v", namespace)
+	}
+	return svc, nil
+}
@@ -0,0 +1,74 @@
+package xc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+)
+
+type namespaceTestProcess struct {
+	ProcessDefaults
+	state AsyncState
+}
+
+func (p namespaceTestProcess) GetAsyncStateSchema() StateSchema {
+	return NewStateSchema(p.state)
+}
+
+type namespaceTestState struct {
+	AsyncStateDefaultsSkipWaitUntil
+	executedNamespaces chan string
+}
+
+func (s namespaceTestState) Execute(
+	ctx Context, input Object, commandResults CommandResults, persistence Persistence, communication Communication,
+) (*StateDecision, error) {
+	s.executedNamespaces <- ctx.GetNamespace()
+	return DeadEnd, nil
+}
+
+func TestWorkerServiceRouterRoutesByNamespace(t *testing.T) {
+	executedNamespaces := make(chan string, 10)
+	registryA := NewRegistry()
+	registryB := NewRegistry()
+	// the same process type is registered in both namespaces
+	state := namespaceTestState{executedNamespaces: executedNamespaces}
+	assert.Nil(t, registryA.AddProcess(namespaceTestProcess{state: state}))
+	assert.Nil(t, registryB.AddProcess(namespaceTestProcess{state: state}))
+
+	router := NewWorkerServiceRouter(map[string]Registry{
+		"team-b": registryB,
+		"team-a": registryA,
+	}, nil)
+	assert.Equal(t, []string{"team-a", "team-b"}, router.GetNamespaces())
+
+	request := xcapi.AsyncStateExecuteRequest{
+		Context: xcapi.Context{
+			ProcessId: "process-1",
+			Attempt:   xcapi.PtrInt32(1),
+		},
+		ProcessType: GetFinalProcessType(namespaceTestProcess{}),
+		StateId:     GetFinalStateId(namespaceTestState{}),
+	}
+	_, err := router.HandleAsyncStateExecute(context.Background(), "team-b", request)
+	assert.Nil(t, err)
+	assert.Equal(t, "team-b", <-executedNamespaces)
+
+	_, err = router.HandleAsyncStateExecute(context.Background(), "team-a", request)
+	assert.Nil(t, err)
+	assert.Equal(t, "team-a", <-executedNamespaces)
+
+	_, err = router.HandleAsyncStateExecute(context.Background(), "team-c", request)
+	assert.NotNil(t, err)
+}
+
+func TestNamespacedUrlsAndContext(t *testing.T) {
+	assert.Equal(t, "/team-a"+ApiPathAsyncStateExecute, GetNamespacedApiPath("team-a", ApiPathAsyncStateExecute))
+	assert.Equal(t, "http://localhost:8803/team-a", GetNamespacedWorkerUrl("http://localhost:8803/", "team-a"))
+
+	ctx := context.Background()
+	assert.Equal(t, DefaultNamespace, getNamespace(ctx, DefaultNamespace))
+	assert.Equal(t, "team-a", getNamespace(WithNamespace(ctx, "team-a"), DefaultNamespace))
+}
@@ -0,0 +1,53 @@
+package xcgrpc
+
+import (
+	"context"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+	"github.com/xcherryio/sdk-go/xc/xcgrpc/xcpb"
+	"google.golang.org/grpc"
+)
+
+// NewClientTransport returns the gRPC xc.ClientTransport with the connection to the ProcessService,
+// to use by xc.ClientOptions.Transport.
+// The errors are returned as *xc.ApiError, so that helpers like xc.IsProcessAlreadyStartedError work the same
+func NewClientTransport(conn grpc.ClientConnInterface) xc.ClientTransport {
+	return &clientTransport{
+		client: xcpb.NewProcessServiceClient(conn),
+	}
+}
+
+type clientTransport struct {
+	client xcpb.ProcessServiceClient
+}
+
+func (t *clientTransport) StartProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionStartRequest,
+) (*xcapi.ProcessExecutionStartResponse, error) {
+	resp, err := t.client.Start(ctx, toPbProcessExecutionStartRequest(&request))
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return fromPbProcessExecutionStartResponse(resp), nil
+}
+
+func (t *clientTransport) StopProcessExecution(ctx context.Context, request xcapi.ProcessExecutionStopRequest) error {
+	_, err := t.client.Stop(ctx, toPbProcessExecutionStopRequest(&request))
+	return fromStatusError(err)
+}
+
+func (t *clientTransport) DescribeProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionDescribeRequest,
+) (*xcapi.ProcessExecutionDescribeResponse, error) {
+	resp, err := t.client.Describe(ctx, toPbProcessExecutionDescribeRequest(&request))
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return fromPbProcessExecutionDescribeResponse(resp), nil
+}
+
+func (t *clientTransport) PublishToLocalQueue(ctx context.Context, request xcapi.PublishToLocalQueueRequest) error {
+	_, err := t.client.PublishToLocalQueue(ctx, toPbPublishToLocalQueueRequest(&request))
+	return fromStatusError(err)
+}
@@ -0,0 +1,90 @@
+package xcgrpc
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var httpStatusToCode = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusRequestTimeout:      codes.DeadlineExceeded,
+	http.StatusConflict:            codes.AlreadyExists,
+	http.StatusLocked:              codes.Aborted,
+	http.StatusFailedDependency:    codes.FailedPrecondition,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	http.StatusNotImplemented:      codes.Unimplemented,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusInternalServerError: codes.Internal,
+}
+
+var codeToHttpStatus = func() map[codes.Code]int {
+	m := map[codes.Code]int{}
+	for httpStatus, code := range httpStatusToCode {
+		m[code] = httpStatus
+	}
+	return m
+}()
+
+// NewApiStatusError returns the gRPC status error of the xCherry API error,
+// the message of the status is the JSON of the ApiErrorResponse
+func NewApiStatusError(code codes.Code, resp xcapi.ApiErrorResponse) error {
+	bs, err := json.Marshal(resp)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return status.Error(code, string(bs))
+}
+
+// ToStatusError converts the error of a xc.ClientTransport to the gRPC status error,
+// which is converted back to the same xc.ApiError by the gRPC ClientTransport
+func ToStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	apiErr, ok := err.(*xc.ApiError)
+	if !ok {
+		return status.Error(codes.Internal, err.Error())
+	}
+	code, ok := httpStatusToCode[apiErr.StatusCode]
+	if !ok {
+		code = codes.Unknown
+	}
+	if apiErr.ErrResponse != nil {
+		return NewApiStatusError(code, *apiErr.ErrResponse)
+	}
+	return status.Error(code, apiErr.Error())
+}
+
+func fromStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return xc.NewApiError(err, nil, nil, nil)
+	}
+	statusCode, ok := codeToHttpStatus[st.Code()]
+	if !ok {
+		statusCode = http.StatusInternalServerError
+	}
+	var errResp xcapi.ApiErrorResponse
+	if json.Unmarshal([]byte(st.Message()), &errResp) != nil {
+		errResp = xcapi.ApiErrorResponse{
+			Details: xcapi.PtrString(st.Message()),
+		}
+	}
+	apiErr := xc.NewApiError(err, nil, nil, &errResp).(*xc.ApiError)
+	apiErr.StatusCode = statusCode
+	return apiErr
+}
@@ -0,0 +1,765 @@
+package xcgrpc
+
+import (
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc/xcgrpc/xcpb"
+)
+
+// The messages of xcherry.proto mirror the xcapi models of the same names, so that the xcapi models are used
+// on both sides like the REST APIs. The enums of the models are the strings of the enum values.
+// The toPb* functions convert the xcapi models into the messages, and the fromPb* functions convert back.
+
+func mapSlice[From any, To any](from []From, convert func(From) To) []To {
+	if len(from) == 0 {
+		return nil
+	}
+	to := make([]To, 0, len(from))
+	for _, f := range from {
+		to = append(to, convert(f))
+	}
+	return to
+}
+
+func toPbEnum[T ~string](v *T) *string {
+	if v == nil {
+		return nil
+	}
+	s := string(*v)
+	return &s
+}
+
+func fromPbEnum[T ~string](v *string) *T {
+	if v == nil {
+		return nil
+	}
+	t := T(*v)
+	return &t
+}
+
+func toPbProcessExecutionStartRequest(r *xcapi.ProcessExecutionStartRequest) *xcpb.ProcessExecutionStartRequest {
+	return &xcpb.ProcessExecutionStartRequest{
+		Namespace:          r.Namespace,
+		ProcessId:          r.ProcessId,
+		ProcessType:        r.ProcessType,
+		WorkerUrl:          r.WorkerUrl,
+		StartStateId:       r.StartStateId,
+		StartStateInput:    toPbEncodedObject(r.StartStateInput),
+		StartStateConfig:   toPbAsyncStateConfig(r.StartStateConfig),
+		ProcessStartConfig: toPbProcessStartConfig(r.ProcessStartConfig),
+	}
+}
+
+func fromPbProcessExecutionStartRequest(m *xcpb.ProcessExecutionStartRequest) *xcapi.ProcessExecutionStartRequest {
+	return &xcapi.ProcessExecutionStartRequest{
+		Namespace:          m.GetNamespace(),
+		ProcessId:          m.GetProcessId(),
+		ProcessType:        m.GetProcessType(),
+		WorkerUrl:          m.GetWorkerUrl(),
+		StartStateId:       m.StartStateId,
+		StartStateInput:    fromPbEncodedObject(m.GetStartStateInput()),
+		StartStateConfig:   fromPbAsyncStateConfig(m.GetStartStateConfig()),
+		ProcessStartConfig: fromPbProcessStartConfig(m.GetProcessStartConfig()),
+	}
+}
+
+func toPbProcessExecutionStartResponse(r *xcapi.ProcessExecutionStartResponse) *xcpb.ProcessExecutionStartResponse {
+	return &xcpb.ProcessExecutionStartResponse{
+		ProcessExecutionId: r.ProcessExecutionId,
+	}
+}
+
+func fromPbProcessExecutionStartResponse(m *xcpb.ProcessExecutionStartResponse) *xcapi.ProcessExecutionStartResponse {
+	return &xcapi.ProcessExecutionStartResponse{
+		ProcessExecutionId: m.GetProcessExecutionId(),
+	}
+}
+
+func toPbProcessExecutionStopRequest(r *xcapi.ProcessExecutionStopRequest) *xcpb.ProcessExecutionStopRequest {
+	return &xcpb.ProcessExecutionStopRequest{
+		Namespace: r.Namespace,
+		ProcessId: r.ProcessId,
+		StopType:  toPbEnum(r.StopType),
+	}
+}
+
+func fromPbProcessExecutionStopRequest(m *xcpb.ProcessExecutionStopRequest) *xcapi.ProcessExecutionStopRequest {
+	return &xcapi.ProcessExecutionStopRequest{
+		Namespace: m.GetNamespace(),
+		ProcessId: m.GetProcessId(),
+		StopType:  fromPbEnum[xcapi.ProcessExecutionStopType](m.StopType),
+	}
+}
+
+func toPbProcessExecutionDescribeRequest(
+	r *xcapi.ProcessExecutionDescribeRequest,
+) *xcpb.ProcessExecutionDescribeRequest {
+	return &xcpb.ProcessExecutionDescribeRequest{
+		Namespace: r.Namespace,
+		ProcessId: r.ProcessId,
+	}
+}
+
+func fromPbProcessExecutionDescribeRequest(
+	m *xcpb.ProcessExecutionDescribeRequest,
+) *xcapi.ProcessExecutionDescribeRequest {
+	return &xcapi.ProcessExecutionDescribeRequest{
+		Namespace: m.GetNamespace(),
+		ProcessId: m.GetProcessId(),
+	}
+}
+
+func toPbProcessExecutionDescribeResponse(
+	r *xcapi.ProcessExecutionDescribeResponse,
+) *xcpb.ProcessExecutionDescribeResponse {
+	return &xcpb.ProcessExecutionDescribeResponse{
+		ProcessExecutionId: r.ProcessExecutionId,
+		ProcessType:        r.ProcessType,
+		WorkerUrl:          r.WorkerUrl,
+		StartTimestamp:     r.StartTimestamp,
+		Status:             toPbEnum(r.Status),
+	}
+}
+
+func fromPbProcessExecutionDescribeResponse(
+	m *xcpb.ProcessExecutionDescribeResponse,
+) *xcapi.ProcessExecutionDescribeResponse {
+	return &xcapi.ProcessExecutionDescribeResponse{
+		ProcessExecutionId: m.ProcessExecutionId,
+		ProcessType:        m.ProcessType,
+		WorkerUrl:          m.WorkerUrl,
+		StartTimestamp:     m.StartTimestamp,
+		Status:             fromPbEnum[xcapi.ProcessStatus](m.Status),
+	}
+}
+
+func toPbPublishToLocalQueueRequest(r *xcapi.PublishToLocalQueueRequest) *xcpb.PublishToLocalQueueRequest {
+	return &xcpb.PublishToLocalQueueRequest{
+		Namespace: r.Namespace,
+		ProcessId: r.ProcessId,
+		Messages:  mapSlice(r.Messages, toPbLocalQueueMessage),
+	}
+}
+
+func fromPbPublishToLocalQueueRequest(m *xcpb.PublishToLocalQueueRequest) *xcapi.PublishToLocalQueueRequest {
+	return &xcapi.PublishToLocalQueueRequest{
+		Namespace: m.GetNamespace(),
+		ProcessId: m.GetProcessId(),
+		Messages:  mapSlice(m.GetMessages(), fromPbLocalQueueMessage),
+	}
+}
+
+func toPbAsyncStateWaitUntilRequest(r *xcapi.AsyncStateWaitUntilRequest) *xcpb.AsyncStateWaitUntilRequest {
+	return &xcpb.AsyncStateWaitUntilRequest{
+		Context:     toPbContext(r.Context),
+		ProcessType: r.ProcessType,
+		StateId:     r.StateId,
+		StateInput:  toPbEncodedObject(r.StateInput),
+	}
+}
+
+func fromPbAsyncStateWaitUntilRequest(m *xcpb.AsyncStateWaitUntilRequest) *xcapi.AsyncStateWaitUntilRequest {
+	return &xcapi.AsyncStateWaitUntilRequest{
+		Context:     fromPbContext(m.GetContext()),
+		ProcessType: m.GetProcessType(),
+		StateId:     m.GetStateId(),
+		StateInput:  fromPbEncodedObject(m.GetStateInput()),
+	}
+}
+
+func toPbAsyncStateWaitUntilResponse(r *xcapi.AsyncStateWaitUntilResponse) *xcpb.AsyncStateWaitUntilResponse {
+	return &xcpb.AsyncStateWaitUntilResponse{
+		CommandRequest:      toPbCommandRequest(r.CommandRequest),
+		PublishToLocalQueue: mapSlice(r.PublishToLocalQueue, toPbLocalQueueMessage),
+	}
+}
+
+func fromPbAsyncStateWaitUntilResponse(m *xcpb.AsyncStateWaitUntilResponse) *xcapi.AsyncStateWaitUntilResponse {
+	return &xcapi.AsyncStateWaitUntilResponse{
+		CommandRequest:      fromPbCommandRequest(m.GetCommandRequest()),
+		PublishToLocalQueue: mapSlice(m.GetPublishToLocalQueue(), fromPbLocalQueueMessage),
+	}
+}
+
+func toPbAsyncStateExecuteRequest(r *xcapi.AsyncStateExecuteRequest) *xcpb.AsyncStateExecuteRequest {
+	return &xcpb.AsyncStateExecuteRequest{
+		Context:                 toPbContext(r.Context),
+		ProcessType:             r.ProcessType,
+		StateId:                 r.StateId,
+		StateInput:              toPbEncodedObject(r.StateInput),
+		CommandResults:          toPbCommandResults(r.CommandResults),
+		AppDatabaseReadResponse: toPbAppDatabaseReadResponse(r.AppDatabaseReadResponse),
+		AppDatabaseError:        toPbAppDatabaseError(r.AppDatabaseError),
+		LoadedLocalAttributes:   toPbLoadLocalAttributesResponse(r.LoadedLocalAttributes),
+	}
+}
+
+func fromPbAsyncStateExecuteRequest(m *xcpb.AsyncStateExecuteRequest) *xcapi.AsyncStateExecuteRequest {
+	return &xcapi.AsyncStateExecuteRequest{
+		Context:                 fromPbContext(m.GetContext()),
+		ProcessType:             m.GetProcessType(),
+		StateId:                 m.GetStateId(),
+		StateInput:              fromPbEncodedObject(m.GetStateInput()),
+		CommandResults:          fromPbCommandResults(m.GetCommandResults()),
+		AppDatabaseReadResponse: fromPbAppDatabaseReadResponse(m.GetAppDatabaseReadResponse()),
+		AppDatabaseError:        fromPbAppDatabaseError(m.GetAppDatabaseError()),
+		LoadedLocalAttributes:   fromPbLoadLocalAttributesResponse(m.GetLoadedLocalAttributes()),
+	}
+}
+
+func toPbAsyncStateExecuteResponse(r *xcapi.AsyncStateExecuteResponse) *xcpb.AsyncStateExecuteResponse {
+	return &xcpb.AsyncStateExecuteResponse{
+		StateDecision:          toPbStateDecision(r.StateDecision),
+		PublishToLocalQueue:    mapSlice(r.PublishToLocalQueue, toPbLocalQueueMessage),
+		WriteToAppDatabase:     toPbAppDatabaseWrite(r.WriteToAppDatabase),
+		WriteToLocalAttributes: mapSlice(r.WriteToLocalAttributes, toPbKeyValue),
+	}
+}
+
+func fromPbAsyncStateExecuteResponse(m *xcpb.AsyncStateExecuteResponse) *xcapi.AsyncStateExecuteResponse {
+	return &xcapi.AsyncStateExecuteResponse{
+		StateDecision:          fromPbStateDecision(m.GetStateDecision()),
+		PublishToLocalQueue:    mapSlice(m.GetPublishToLocalQueue(), fromPbLocalQueueMessage),
+		WriteToAppDatabase:     fromPbAppDatabaseWrite(m.GetWriteToAppDatabase()),
+		WriteToLocalAttributes: mapSlice(m.GetWriteToLocalAttributes(), fromPbKeyValue),
+	}
+}
+
+func toPbEncodedObject(r *xcapi.EncodedObject) *xcpb.EncodedObject {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.EncodedObject{
+		Encoding: r.Encoding,
+		Data:     r.Data,
+	}
+}
+
+func fromPbEncodedObject(m *xcpb.EncodedObject) *xcapi.EncodedObject {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.EncodedObject{
+		Encoding: m.GetEncoding(),
+		Data:     m.GetData(),
+	}
+}
+
+func toPbKeyValue(r xcapi.KeyValue) *xcpb.KeyValue {
+	return &xcpb.KeyValue{
+		Key:   r.Key,
+		Value: toPbEncodedObject(&r.Value),
+	}
+}
+
+func fromPbKeyValue(m *xcpb.KeyValue) xcapi.KeyValue {
+	kv := xcapi.KeyValue{
+		Key: m.GetKey(),
+	}
+	if value := fromPbEncodedObject(m.GetValue()); value != nil {
+		kv.Value = *value
+	}
+	return kv
+}
+
+func toPbContext(r xcapi.Context) *xcpb.Context {
+	return &xcpb.Context{
+		ProcessId:                   r.ProcessId,
+		ProcessExecutionId:          r.ProcessExecutionId,
+		ProcessStartedTimestamp:     r.ProcessStartedTimestamp,
+		StateExecutionId:            r.StateExecutionId,
+		FirstAttemptTimestamp:       r.FirstAttemptTimestamp,
+		Attempt:                     r.Attempt,
+		RecoverFromStateExecutionId: r.RecoverFromStateExecutionId,
+		RecoverFromApi:              toPbEnum(r.RecoverFromApi),
+	}
+}
+
+func fromPbContext(m *xcpb.Context) xcapi.Context {
+	if m == nil {
+		return xcapi.Context{}
+	}
+	return xcapi.Context{
+		ProcessId:                   m.GetProcessId(),
+		ProcessExecutionId:          m.GetProcessExecutionId(),
+		ProcessStartedTimestamp:     m.GetProcessStartedTimestamp(),
+		StateExecutionId:            m.StateExecutionId,
+		FirstAttemptTimestamp:       m.FirstAttemptTimestamp,
+		Attempt:                     m.Attempt,
+		RecoverFromStateExecutionId: m.RecoverFromStateExecutionId,
+		RecoverFromApi:              fromPbEnum[xcapi.WorkerApiType](m.RecoverFromApi),
+	}
+}
+
+func toPbAsyncStateConfig(r *xcapi.AsyncStateConfig) *xcpb.AsyncStateConfig {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.AsyncStateConfig{
+		SkipWaitUntil:               r.SkipWaitUntil,
+		WaitUntilApiTimeoutSeconds:  r.WaitUntilApiTimeoutSeconds,
+		ExecuteApiTimeoutSeconds:    r.ExecuteApiTimeoutSeconds,
+		WaitUntilApiRetryPolicy:     toPbRetryPolicy(r.WaitUntilApiRetryPolicy),
+		ExecuteApiRetryPolicy:       toPbRetryPolicy(r.ExecuteApiRetryPolicy),
+		StateFailureRecoveryOptions: toPbStateFailureRecoveryOptions(r.StateFailureRecoveryOptions),
+		AppDatabaseReadRequest:      toPbAppDatabaseReadRequest(r.AppDatabaseReadRequest),
+		LoadLocalAttributesRequest:  toPbLoadLocalAttributesRequest(r.LoadLocalAttributesRequest),
+	}
+}
+
+func fromPbAsyncStateConfig(m *xcpb.AsyncStateConfig) *xcapi.AsyncStateConfig {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.AsyncStateConfig{
+		SkipWaitUntil:               m.SkipWaitUntil,
+		WaitUntilApiTimeoutSeconds:  m.WaitUntilApiTimeoutSeconds,
+		ExecuteApiTimeoutSeconds:    m.ExecuteApiTimeoutSeconds,
+		WaitUntilApiRetryPolicy:     fromPbRetryPolicy(m.GetWaitUntilApiRetryPolicy()),
+		ExecuteApiRetryPolicy:       fromPbRetryPolicy(m.GetExecuteApiRetryPolicy()),
+		StateFailureRecoveryOptions: fromPbStateFailureRecoveryOptions(m.GetStateFailureRecoveryOptions()),
+		AppDatabaseReadRequest:      fromPbAppDatabaseReadRequest(m.GetAppDatabaseReadRequest()),
+		LoadLocalAttributesRequest:  fromPbLoadLocalAttributesRequest(m.GetLoadLocalAttributesRequest()),
+	}
+}
+
+func toPbRetryPolicy(r *xcapi.RetryPolicy) *xcpb.RetryPolicy {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.RetryPolicy{
+		InitialIntervalSeconds:         r.InitialIntervalSeconds,
+		BackoffCoefficient:             r.BackoffCoefficient,
+		MaximumIntervalSeconds:         r.MaximumIntervalSeconds,
+		MaximumAttempts:                r.MaximumAttempts,
+		MaximumAttemptsDurationSeconds: r.MaximumAttemptsDurationSeconds,
+	}
+}
+
+func fromPbRetryPolicy(m *xcpb.RetryPolicy) *xcapi.RetryPolicy {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.RetryPolicy{
+		InitialIntervalSeconds:         m.InitialIntervalSeconds,
+		BackoffCoefficient:             m.BackoffCoefficient,
+		MaximumIntervalSeconds:         m.MaximumIntervalSeconds,
+		MaximumAttempts:                m.MaximumAttempts,
+		MaximumAttemptsDurationSeconds: m.MaximumAttemptsDurationSeconds,
+	}
+}
+
+func toPbStateFailureRecoveryOptions(r *xcapi.StateFailureRecoveryOptions) *xcpb.StateFailureRecoveryOptions {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.StateFailureRecoveryOptions{
+		Policy:                         string(r.Policy),
+		StateFailureProceedStateId:     r.StateFailureProceedStateId,
+		StateFailureProceedStateConfig: toPbAsyncStateConfig(r.StateFailureProceedStateConfig),
+	}
+}
+
+func fromPbStateFailureRecoveryOptions(m *xcpb.StateFailureRecoveryOptions) *xcapi.StateFailureRecoveryOptions {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.StateFailureRecoveryOptions{
+		Policy:                         xcapi.StateFailureRecoveryPolicy(m.GetPolicy()),
+		StateFailureProceedStateId:     m.StateFailureProceedStateId,
+		StateFailureProceedStateConfig: fromPbAsyncStateConfig(m.GetStateFailureProceedStateConfig()),
+	}
+}
+
+func toPbProcessStartConfig(r *xcapi.ProcessStartConfig) *xcpb.ProcessStartConfig {
+	if r == nil {
+		return nil
+	}
+	m := &xcpb.ProcessStartConfig{
+		TimeoutSeconds:    r.TimeoutSeconds,
+		IdReusePolicy:     toPbEnum(r.IdReusePolicy),
+		AppDatabaseConfig: toPbAppDatabaseConfig(r.AppDatabaseConfig),
+	}
+	if r.LocalAttributeConfig != nil {
+		m.LocalAttributeConfig = &xcpb.LocalAttributeConfig{
+			InitialWrite: mapSlice(r.LocalAttributeConfig.InitialWrite, toPbKeyValue),
+		}
+	}
+	return m
+}
+
+func fromPbProcessStartConfig(m *xcpb.ProcessStartConfig) *xcapi.ProcessStartConfig {
+	if m == nil {
+		return nil
+	}
+	r := &xcapi.ProcessStartConfig{
+		TimeoutSeconds:    m.TimeoutSeconds,
+		IdReusePolicy:     fromPbEnum[xcapi.ProcessIdReusePolicy](m.IdReusePolicy),
+		AppDatabaseConfig: fromPbAppDatabaseConfig(m.GetAppDatabaseConfig()),
+	}
+	if m.LocalAttributeConfig != nil {
+		r.LocalAttributeConfig = &xcapi.LocalAttributeConfig{
+			InitialWrite: mapSlice(m.LocalAttributeConfig.GetInitialWrite(), fromPbKeyValue),
+		}
+	}
+	return r
+}
+
+func toPbLoadLocalAttributesRequest(r *xcapi.LoadLocalAttributesRequest) *xcpb.LoadLocalAttributesRequest {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.LoadLocalAttributesRequest{
+		KeysToLoadNoLock:   r.KeysToLoadNoLock,
+		KeysToLoadWithLock: r.KeysToLoadWithLock,
+		LockType:           toPbEnum(r.LockType),
+	}
+}
+
+func fromPbLoadLocalAttributesRequest(m *xcpb.LoadLocalAttributesRequest) *xcapi.LoadLocalAttributesRequest {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.LoadLocalAttributesRequest{
+		KeysToLoadNoLock:   m.GetKeysToLoadNoLock(),
+		KeysToLoadWithLock: m.GetKeysToLoadWithLock(),
+		LockType:           fromPbEnum[xcapi.LockType](m.LockType),
+	}
+}
+
+func toPbLoadLocalAttributesResponse(r *xcapi.LoadLocalAttributesResponse) *xcpb.LoadLocalAttributesResponse {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.LoadLocalAttributesResponse{
+		Attributes: mapSlice(r.Attributes, toPbKeyValue),
+	}
+}
+
+func fromPbLoadLocalAttributesResponse(m *xcpb.LoadLocalAttributesResponse) *xcapi.LoadLocalAttributesResponse {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.LoadLocalAttributesResponse{
+		Attributes: mapSlice(m.GetAttributes(), fromPbKeyValue),
+	}
+}
+
+func toPbLocalQueueMessage(r xcapi.LocalQueueMessage) *xcpb.LocalQueueMessage {
+	return &xcpb.LocalQueueMessage{
+		QueueName: r.QueueName,
+		DedupId:   r.DedupId,
+		Payload:   toPbEncodedObject(r.Payload),
+	}
+}
+
+func fromPbLocalQueueMessage(m *xcpb.LocalQueueMessage) xcapi.LocalQueueMessage {
+	return xcapi.LocalQueueMessage{
+		QueueName: m.GetQueueName(),
+		DedupId:   m.DedupId,
+		Payload:   fromPbEncodedObject(m.GetPayload()),
+	}
+}
+
+func toPbCommandRequest(r xcapi.CommandRequest) *xcpb.CommandRequest {
+	return &xcpb.CommandRequest{
+		WaitingType: string(r.WaitingType),
+		TimerCommands: mapSlice(r.TimerCommands, func(c xcapi.TimerCommand) *xcpb.TimerCommand {
+			return &xcpb.TimerCommand{
+				DelayInSeconds: c.DelayInSeconds,
+			}
+		}),
+		LocalQueueCommands: mapSlice(r.LocalQueueCommands, func(c xcapi.LocalQueueCommand) *xcpb.LocalQueueCommand {
+			return &xcpb.LocalQueueCommand{
+				QueueName: c.QueueName,
+				Count:     c.Count,
+			}
+		}),
+	}
+}
+
+func fromPbCommandRequest(m *xcpb.CommandRequest) xcapi.CommandRequest {
+	return xcapi.CommandRequest{
+		WaitingType: xcapi.CommandWaitingType(m.GetWaitingType()),
+		TimerCommands: mapSlice(m.GetTimerCommands(), func(c *xcpb.TimerCommand) xcapi.TimerCommand {
+			return xcapi.TimerCommand{
+				DelayInSeconds: c.GetDelayInSeconds(),
+			}
+		}),
+		LocalQueueCommands: mapSlice(m.GetLocalQueueCommands(), func(c *xcpb.LocalQueueCommand) xcapi.LocalQueueCommand {
+			return xcapi.LocalQueueCommand{
+				QueueName: c.GetQueueName(),
+				Count:     c.Count,
+			}
+		}),
+	}
+}
+
+func toPbCommandResults(r *xcapi.CommandResults) *xcpb.CommandResults {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.CommandResults{
+		TimerResults: mapSlice(r.TimerResults, func(t xcapi.TimerResult) *xcpb.TimerResult {
+			return &xcpb.TimerResult{
+				Status: string(t.Status),
+			}
+		}),
+		LocalQueueResults: mapSlice(r.LocalQueueResults, func(q xcapi.LocalQueueResult) *xcpb.LocalQueueResult {
+			return &xcpb.LocalQueueResult{
+				Status:    string(q.Status),
+				QueueName: q.QueueName,
+				Messages: mapSlice(q.Messages, func(msg xcapi.LocalQueueMessageResult) *xcpb.LocalQueueMessageResult {
+					return &xcpb.LocalQueueMessageResult{
+						DedupId: msg.DedupId,
+						Payload: toPbEncodedObject(msg.Payload),
+					}
+				}),
+			}
+		}),
+	}
+}
+
+func fromPbCommandResults(m *xcpb.CommandResults) *xcapi.CommandResults {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.CommandResults{
+		TimerResults: mapSlice(m.GetTimerResults(), func(t *xcpb.TimerResult) xcapi.TimerResult {
+			return xcapi.TimerResult{
+				Status: xcapi.CommandStatus(t.GetStatus()),
+			}
+		}),
+		LocalQueueResults: mapSlice(m.GetLocalQueueResults(), func(q *xcpb.LocalQueueResult) xcapi.LocalQueueResult {
+			return xcapi.LocalQueueResult{
+				Status:    xcapi.CommandStatus(q.GetStatus()),
+				QueueName: q.GetQueueName(),
+				Messages: mapSlice(q.GetMessages(), func(msg *xcpb.LocalQueueMessageResult) xcapi.LocalQueueMessageResult {
+					return xcapi.LocalQueueMessageResult{
+						DedupId: msg.GetDedupId(),
+						Payload: fromPbEncodedObject(msg.GetPayload()),
+					}
+				}),
+			}
+		}),
+	}
+}
+
+func toPbStateDecision(r xcapi.StateDecision) *xcpb.StateDecision {
+	m := &xcpb.StateDecision{
+		NextStates: mapSlice(r.NextStates, func(s xcapi.StateMovement) *xcpb.StateMovement {
+			return &xcpb.StateMovement{
+				StateId:     s.StateId,
+				StateInput:  toPbEncodedObject(s.StateInput),
+				StateConfig: toPbAsyncStateConfig(s.StateConfig),
+			}
+		}),
+	}
+	if r.ThreadCloseDecision != nil {
+		m.ThreadCloseDecision = &xcpb.ThreadCloseDecision{
+			CloseType:  string(r.ThreadCloseDecision.CloseType),
+			CloseInput: toPbEncodedObject(r.ThreadCloseDecision.CloseInput),
+		}
+	}
+	return m
+}
+
+func fromPbStateDecision(m *xcpb.StateDecision) xcapi.StateDecision {
+	r := xcapi.StateDecision{
+		NextStates: mapSlice(m.GetNextStates(), func(s *xcpb.StateMovement) xcapi.StateMovement {
+			return xcapi.StateMovement{
+				StateId:     s.GetStateId(),
+				StateInput:  fromPbEncodedObject(s.GetStateInput()),
+				StateConfig: fromPbAsyncStateConfig(s.GetStateConfig()),
+			}
+		}),
+	}
+	if d := m.GetThreadCloseDecision(); d != nil {
+		r.ThreadCloseDecision = &xcapi.ThreadCloseDecision{
+			CloseType:  xcapi.ThreadCloseType(d.GetCloseType()),
+			CloseInput: fromPbEncodedObject(d.GetCloseInput()),
+		}
+	}
+	return r
+}
+
+func toPbAppDatabaseColumnValue(r xcapi.AppDatabaseColumnValue) *xcpb.AppDatabaseColumnValue {
+	return &xcpb.AppDatabaseColumnValue{
+		Column:     r.Column,
+		QueryValue: r.QueryValue,
+	}
+}
+
+func fromPbAppDatabaseColumnValue(m *xcpb.AppDatabaseColumnValue) xcapi.AppDatabaseColumnValue {
+	return xcapi.AppDatabaseColumnValue{
+		Column:     m.GetColumn(),
+		QueryValue: m.GetQueryValue(),
+	}
+}
+
+func toPbAppDatabaseConfig(r *xcapi.AppDatabaseConfig) *xcpb.AppDatabaseConfig {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.AppDatabaseConfig{
+		Tables: mapSlice(r.Tables, func(t xcapi.AppDatabaseTableConfig) *xcpb.AppDatabaseTableConfig {
+			return &xcpb.AppDatabaseTableConfig{
+				TableName: t.TableName,
+				Rows: mapSlice(t.Rows, func(row xcapi.AppDatabaseTableRowSelector) *xcpb.AppDatabaseTableRowSelector {
+					return &xcpb.AppDatabaseTableRowSelector{
+						PrimaryKey:   mapSlice(row.PrimaryKey, toPbAppDatabaseColumnValue),
+						InitialWrite: mapSlice(row.InitialWrite, toPbAppDatabaseColumnValue),
+						ConflictMode: toPbEnum(row.ConflictMode),
+					}
+				}),
+			}
+		}),
+	}
+}
+
+func fromPbAppDatabaseConfig(m *xcpb.AppDatabaseConfig) *xcapi.AppDatabaseConfig {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.AppDatabaseConfig{
+		Tables: mapSlice(m.GetTables(), func(t *xcpb.AppDatabaseTableConfig) xcapi.AppDatabaseTableConfig {
+			return xcapi.AppDatabaseTableConfig{
+				TableName: t.GetTableName(),
+				Rows: mapSlice(t.GetRows(), func(row *xcpb.AppDatabaseTableRowSelector) xcapi.AppDatabaseTableRowSelector {
+					return xcapi.AppDatabaseTableRowSelector{
+						PrimaryKey:   mapSlice(row.GetPrimaryKey(), fromPbAppDatabaseColumnValue),
+						InitialWrite: mapSlice(row.GetInitialWrite(), fromPbAppDatabaseColumnValue),
+						ConflictMode: fromPbEnum[xcapi.WriteConflictMode](row.ConflictMode),
+					}
+				}),
+			}
+		}),
+	}
+}
+
+func toPbAppDatabaseReadRequest(r *xcapi.AppDatabaseReadRequest) *xcpb.AppDatabaseReadRequest {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.AppDatabaseReadRequest{
+		Tables: mapSlice(r.Tables, func(t xcapi.AppDatabaseTableReadRequest) *xcpb.AppDatabaseTableReadRequest {
+			return &xcpb.AppDatabaseTableReadRequest{
+				TableName: t.TableName,
+				LockType:  toPbEnum(t.LockType),
+				Columns:   t.Columns,
+			}
+		}),
+	}
+}
+
+func fromPbAppDatabaseReadRequest(m *xcpb.AppDatabaseReadRequest) *xcapi.AppDatabaseReadRequest {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.AppDatabaseReadRequest{
+		Tables: mapSlice(m.GetTables(), func(t *xcpb.AppDatabaseTableReadRequest) xcapi.AppDatabaseTableReadRequest {
+			return xcapi.AppDatabaseTableReadRequest{
+				TableName: t.TableName,
+				LockType:  fromPbEnum[xcapi.LockType](t.LockType),
+				Columns:   t.GetColumns(),
+			}
+		}),
+	}
+}
+
+func toPbAppDatabaseReadResponse(r *xcapi.AppDatabaseReadResponse) *xcpb.AppDatabaseReadResponse {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.AppDatabaseReadResponse{
+		Tables: mapSlice(r.Tables, func(t xcapi.AppDatabaseTableReadResponse) *xcpb.AppDatabaseTableReadResponse {
+			return &xcpb.AppDatabaseTableReadResponse{
+				TableName: t.TableName,
+				Rows: mapSlice(t.Rows, func(row xcapi.AppDatabaseRowReadResponse) *xcpb.AppDatabaseRowReadResponse {
+					return &xcpb.AppDatabaseRowReadResponse{
+						Columns: mapSlice(row.Columns, toPbAppDatabaseColumnValue),
+					}
+				}),
+			}
+		}),
+	}
+}
+
+func fromPbAppDatabaseReadResponse(m *xcpb.AppDatabaseReadResponse) *xcapi.AppDatabaseReadResponse {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.AppDatabaseReadResponse{
+		Tables: mapSlice(m.GetTables(), func(t *xcpb.AppDatabaseTableReadResponse) xcapi.AppDatabaseTableReadResponse {
+			return xcapi.AppDatabaseTableReadResponse{
+				TableName: t.TableName,
+				Rows: mapSlice(t.GetRows(), func(row *xcpb.AppDatabaseRowReadResponse) xcapi.AppDatabaseRowReadResponse {
+					return xcapi.AppDatabaseRowReadResponse{
+						Columns: mapSlice(row.GetColumns(), fromPbAppDatabaseColumnValue),
+					}
+				}),
+			}
+		}),
+	}
+}
+
+func toPbAppDatabaseError(r *xcapi.AppDatabaseError) *xcpb.AppDatabaseError {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.AppDatabaseError{
+		AppDbErrorType:      string(r.AppDBErrorType),
+		AppDbErrorCode:      r.AppDBErrorCode,
+		AppDbErrorMessage:   r.AppDBErrorMessage,
+		AppDbErrorTableName: r.AppDBErrorTableName,
+	}
+}
+
+func fromPbAppDatabaseError(m *xcpb.AppDatabaseError) *xcapi.AppDatabaseError {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.AppDatabaseError{
+		AppDBErrorType:      xcapi.ErrorSubType(m.GetAppDbErrorType()),
+		AppDBErrorCode:      m.GetAppDbErrorCode(),
+		AppDBErrorMessage:   m.AppDbErrorMessage,
+		AppDBErrorTableName: m.AppDbErrorTableName,
+	}
+}
+
+func toPbAppDatabaseWrite(r *xcapi.AppDatabaseWrite) *xcpb.AppDatabaseWrite {
+	if r == nil {
+		return nil
+	}
+	return &xcpb.AppDatabaseWrite{
+		Tables: mapSlice(r.Tables, func(t xcapi.AppDatabaseTableWrite) *xcpb.AppDatabaseTableWrite {
+			return &xcpb.AppDatabaseTableWrite{
+				TableName: t.TableName,
+				Rows: mapSlice(t.Rows, func(row xcapi.AppDatabaseRowWrite) *xcpb.AppDatabaseRowWrite {
+					return &xcpb.AppDatabaseRowWrite{
+						PrimaryKey:   mapSlice(row.PrimaryKey, toPbAppDatabaseColumnValue),
+						WriteColumns: mapSlice(row.WriteColumns, toPbAppDatabaseColumnValue),
+					}
+				}),
+			}
+		}),
+	}
+}
+
+func fromPbAppDatabaseWrite(m *xcpb.AppDatabaseWrite) *xcapi.AppDatabaseWrite {
+	if m == nil {
+		return nil
+	}
+	return &xcapi.AppDatabaseWrite{
+		Tables: mapSlice(m.GetTables(), func(t *xcpb.AppDatabaseTableWrite) xcapi.AppDatabaseTableWrite {
+			return xcapi.AppDatabaseTableWrite{
+				TableName: t.GetTableName(),
+				Rows: mapSlice(t.GetRows(), func(row *xcpb.AppDatabaseRowWrite) xcapi.AppDatabaseRowWrite {
+					return xcapi.AppDatabaseRowWrite{
+						PrimaryKey:   mapSlice(row.GetPrimaryKey(), fromPbAppDatabaseColumnValue),
+						WriteColumns: mapSlice(row.GetWriteColumns(), fromPbAppDatabaseColumnValue),
+					}
+				}),
+			}
+		}),
+	}
+}
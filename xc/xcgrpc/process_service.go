@@ -0,0 +1,108 @@
+package xcgrpc
+
+import (
+	"context"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+	"github.com/xcherryio/sdk-go/xc/xcgrpc/xcpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const ProcessServiceName = "xcherry.v1.ProcessService"
+
+// ProcessServiceServer is the server side of ProcessService, e.g. a gRPC gateway in front of xCherry server
+type ProcessServiceServer interface {
+	Start(ctx context.Context, request *xcapi.ProcessExecutionStartRequest) (*xcapi.ProcessExecutionStartResponse, error)
+	Stop(ctx context.Context, request *xcapi.ProcessExecutionStopRequest) (*emptypb.Empty, error)
+	Describe(
+		ctx context.Context, request *xcapi.ProcessExecutionDescribeRequest,
+	) (*xcapi.ProcessExecutionDescribeResponse, error)
+	PublishToLocalQueue(ctx context.Context, request *xcapi.PublishToLocalQueueRequest) (*emptypb.Empty, error)
+}
+
+// RegisterProcessServiceServer registers the ProcessServiceServer to the gRPC server
+func RegisterProcessServiceServer(s grpc.ServiceRegistrar, srv ProcessServiceServer) {
+	xcpb.RegisterProcessServiceServer(s, &pbProcessServiceServer{
+		srv: srv,
+	})
+}
+
+// pbProcessServiceServer adapts the ProcessServiceServer of the xcapi models to the generated xcpb.ProcessServiceServer
+type pbProcessServiceServer struct {
+	xcpb.UnimplementedProcessServiceServer
+	srv ProcessServiceServer
+}
+
+func (s *pbProcessServiceServer) Start(
+	ctx context.Context, request *xcpb.ProcessExecutionStartRequest,
+) (*xcpb.ProcessExecutionStartResponse, error) {
+	resp, err := s.srv.Start(ctx, fromPbProcessExecutionStartRequest(request))
+	if err != nil {
+		return nil, err
+	}
+	return toPbProcessExecutionStartResponse(resp), nil
+}
+
+func (s *pbProcessServiceServer) Stop(
+	ctx context.Context, request *xcpb.ProcessExecutionStopRequest,
+) (*emptypb.Empty, error) {
+	return s.srv.Stop(ctx, fromPbProcessExecutionStopRequest(request))
+}
+
+func (s *pbProcessServiceServer) Describe(
+	ctx context.Context, request *xcpb.ProcessExecutionDescribeRequest,
+) (*xcpb.ProcessExecutionDescribeResponse, error) {
+	resp, err := s.srv.Describe(ctx, fromPbProcessExecutionDescribeRequest(request))
+	if err != nil {
+		return nil, err
+	}
+	return toPbProcessExecutionDescribeResponse(resp), nil
+}
+
+func (s *pbProcessServiceServer) PublishToLocalQueue(
+	ctx context.Context, request *xcpb.PublishToLocalQueueRequest,
+) (*emptypb.Empty, error) {
+	return s.srv.PublishToLocalQueue(ctx, fromPbPublishToLocalQueueRequest(request))
+}
+
+// NewProcessServiceServer returns a ProcessServiceServer that forwards the requests with the transport,
+// e.g. with xc.NewRestClientTransport as a gRPC gateway in front of xCherry server
+func NewProcessServiceServer(transport xc.ClientTransport) ProcessServiceServer {
+	return &transportProcessServiceServer{
+		transport: transport,
+	}
+}
+
+type transportProcessServiceServer struct {
+	transport xc.ClientTransport
+}
+
+func (s *transportProcessServiceServer) Start(
+	ctx context.Context, request *xcapi.ProcessExecutionStartRequest,
+) (*xcapi.ProcessExecutionStartResponse, error) {
+	resp, err := s.transport.StartProcessExecution(ctx, *request)
+	return resp, ToStatusError(err)
+}
+
+func (s *transportProcessServiceServer) Stop(
+	ctx context.Context, request *xcapi.ProcessExecutionStopRequest,
+) (*emptypb.Empty, error) {
+	err := s.transport.StopProcessExecution(ctx, *request)
+	return &emptypb.Empty{}, ToStatusError(err)
+}
+
+func (s *transportProcessServiceServer) Describe(
+	ctx context.Context, request *xcapi.ProcessExecutionDescribeRequest,
+) (*xcapi.ProcessExecutionDescribeResponse, error) {
+	resp, err := s.transport.DescribeProcessExecution(ctx, *request)
+	return resp, ToStatusError(err)
+}
+
+func (s *transportProcessServiceServer) PublishToLocalQueue(
+	ctx context.Context, request *xcapi.PublishToLocalQueueRequest,
+) (*emptypb.Empty, error) {
+	err := s.transport.PublishToLocalQueue(ctx, *request)
+	return &emptypb.Empty{}, ToStatusError(err)
+}
@@ -0,0 +1,127 @@
+package xcgrpc
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+	"github.com/xcherryio/sdk-go/xc/xcgrpc/xcpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const WorkerServiceName = "xcherry.v1.WorkerService"
+
+// RetryAfterTrailer is the trailer of the worker errors with the retry delay hint in seconds,
+// like the Retry-After header of the REST worker
+const RetryAfterTrailer = "retry-after"
+
+// RegisterWorkerService registers the xc.WorkerService to the gRPC server,
+// to serve the worker APIs over gRPC instead of(or as well as) the REST ApiPathAsyncStateWaitUntil/Execute.
+// The worker errors are returned with codes.FailedPrecondition, or codes.ResourceExhausted for xc.WorkerOverloadedError,
+// and the message of the status is the JSON of xcapi.WorkerErrorResponse
+func RegisterWorkerService(s grpc.ServiceRegistrar, workerService xc.WorkerService) {
+	srv := &workerServiceServer{
+		workerService: workerService,
+	}
+	xcpb.RegisterWorkerServiceServer(s, srv)
+}
+
+type workerServiceServer struct {
+	xcpb.UnimplementedWorkerServiceServer
+	workerService xc.WorkerService
+}
+
+func (s *workerServiceServer) AsyncStateWaitUntil(
+	ctx context.Context, request *xcpb.AsyncStateWaitUntilRequest,
+) (*xcpb.AsyncStateWaitUntilResponse, error) {
+	resp, err := s.workerService.HandleAsyncStateWaitUntil(ctx, *fromPbAsyncStateWaitUntilRequest(request))
+	if err != nil {
+		return nil, toWorkerStatusError(ctx, err)
+	}
+	return toPbAsyncStateWaitUntilResponse(resp), nil
+}
+
+func (s *workerServiceServer) AsyncStateExecute(
+	ctx context.Context, request *xcpb.AsyncStateExecuteRequest,
+) (*xcpb.AsyncStateExecuteResponse, error) {
+	resp, err := s.workerService.HandleAsyncStateExecute(ctx, *fromPbAsyncStateExecuteRequest(request))
+	if err != nil {
+		return nil, toWorkerStatusError(ctx, err)
+	}
+	return toPbAsyncStateExecuteResponse(resp), nil
+}
+
+func toWorkerStatusError(ctx context.Context, err error) error {
+	if retryAfter, ok := xc.GetRetryAfterSeconds(err); ok {
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(RetryAfterTrailer, strconv.Itoa(retryAfter)))
+	}
+	code := codes.FailedPrecondition
+	if xc.IsWorkerOverloadedError(err) {
+		code = codes.ResourceExhausted
+	}
+	bs, jsonErr := json.Marshal(xc.NewWorkerErrorResponse(err))
+	if jsonErr != nil {
+		return status.Error(codes.Internal, jsonErr.Error())
+	}
+	return status.Error(code, string(bs))
+}
+
+// WorkerServiceClient is the client side of WorkerService, e.g. for a server or tests to invoke the worker
+type WorkerServiceClient interface {
+	AsyncStateWaitUntil(
+		ctx context.Context, request xcapi.AsyncStateWaitUntilRequest, opts ...grpc.CallOption,
+	) (*xcapi.AsyncStateWaitUntilResponse, error)
+	AsyncStateExecute(
+		ctx context.Context, request xcapi.AsyncStateExecuteRequest, opts ...grpc.CallOption,
+	) (*xcapi.AsyncStateExecuteResponse, error)
+}
+
+// NewWorkerServiceClient returns a WorkerServiceClient with the connection to the worker.
+// The errors are the gRPC status errors, see GetWorkerErrorResponse
+func NewWorkerServiceClient(conn grpc.ClientConnInterface) WorkerServiceClient {
+	return &workerServiceClient{
+		client: xcpb.NewWorkerServiceClient(conn),
+	}
+}
+
+type workerServiceClient struct {
+	client xcpb.WorkerServiceClient
+}
+
+func (c *workerServiceClient) AsyncStateWaitUntil(
+	ctx context.Context, request xcapi.AsyncStateWaitUntilRequest, opts ...grpc.CallOption,
+) (*xcapi.AsyncStateWaitUntilResponse, error) {
+	resp, err := c.client.AsyncStateWaitUntil(ctx, toPbAsyncStateWaitUntilRequest(&request), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return fromPbAsyncStateWaitUntilResponse(resp), nil
+}
+
+func (c *workerServiceClient) AsyncStateExecute(
+	ctx context.Context, request xcapi.AsyncStateExecuteRequest, opts ...grpc.CallOption,
+) (*xcapi.AsyncStateExecuteResponse, error) {
+	resp, err := c.client.AsyncStateExecute(ctx, toPbAsyncStateExecuteRequest(&request), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return fromPbAsyncStateExecuteResponse(resp), nil
+}
+
+// GetWorkerErrorResponse returns the xcapi.WorkerErrorResponse of the status error returned by the worker
+func GetWorkerErrorResponse(err error) (*xcapi.WorkerErrorResponse, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	var resp xcapi.WorkerErrorResponse
+	if json.Unmarshal([]byte(st.Message()), &resp) != nil {
+		return nil, false
+	}
+	return &resp, true
+}
@@ -0,0 +1,390 @@
+package xcgrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xcherryio/apis/goapi/xcapi"
+	"github.com/xcherryio/sdk-go/xc"
+	"github.com/xcherryio/sdk-go/xc/xcgrpc/xcpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// standInServer is an in-memory stand-in of xCherry server
+type standInServer struct {
+	sync.Mutex
+	processes map[string]*xcapi.ProcessExecutionDescribeResponse
+	messages  map[string][]xcapi.LocalQueueMessage
+}
+
+func newStandInServer() *standInServer {
+	return &standInServer{
+		processes: map[string]*xcapi.ProcessExecutionDescribeResponse{},
+		messages:  map[string][]xcapi.LocalQueueMessage{},
+	}
+}
+
+func (s *standInServer) StartProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionStartRequest,
+) (*xcapi.ProcessExecutionStartResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.processes[request.ProcessId]; ok {
+		return nil, newStandInApiError(http.StatusConflict, "process is already started")
+	}
+	prcExeId := "execution-" + request.ProcessId
+	s.processes[request.ProcessId] = &xcapi.ProcessExecutionDescribeResponse{
+		ProcessExecutionId: &prcExeId,
+		ProcessType:        &request.ProcessType,
+		WorkerUrl:          &request.WorkerUrl,
+		Status:             xcapi.RUNNING.Ptr(),
+	}
+	return &xcapi.ProcessExecutionStartResponse{ProcessExecutionId: prcExeId}, nil
+}
+
+func (s *standInServer) StopProcessExecution(ctx context.Context, request xcapi.ProcessExecutionStopRequest) error {
+	s.Lock()
+	defer s.Unlock()
+	prc, ok := s.processes[request.ProcessId]
+	if !ok {
+		return newStandInApiError(http.StatusNotFound, "process does not exist")
+	}
+	prc.Status = xcapi.TERMINATED.Ptr()
+	return nil
+}
+
+func (s *standInServer) DescribeProcessExecution(
+	ctx context.Context, request xcapi.ProcessExecutionDescribeRequest,
+) (*xcapi.ProcessExecutionDescribeResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	prc, ok := s.processes[request.ProcessId]
+	if !ok {
+		return nil, newStandInApiError(http.StatusNotFound, "process does not exist")
+	}
+	return prc, nil
+}
+
+func (s *standInServer) PublishToLocalQueue(ctx context.Context, request xcapi.PublishToLocalQueueRequest) error {
+	s.Lock()
+	defer s.Unlock()
+	s.messages[request.ProcessId] = append(s.messages[request.ProcessId], request.Messages...)
+	return nil
+}
+
+func newStandInApiError(statusCode int, details string) error {
+	err := xc.NewApiError(errors.New(details), nil, nil, &xcapi.ApiErrorResponse{
+		Details: &details,
+	}).(*xc.ApiError)
+	err.StatusCode = statusCode
+	return err
+}
+
+func startGrpcServer(t *testing.T, register func(s *grpc.Server)) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	register(server)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestBasicClientWithGrpcTransport(t *testing.T) {
+	server := newStandInServer()
+	conn := startGrpcServer(t, func(s *grpc.Server) {
+		RegisterProcessServiceServer(s, NewProcessServiceServer(server))
+	})
+
+	options := *xc.GetLocalDefaultClientOptions()
+	options.Transport = NewClientTransport(conn)
+	client := xc.NewBasicClient(options)
+	ctx := context.Background()
+
+	prcExeId, err := client.StartProcess(ctx, "test-process", "state1", "process-1", "input", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "execution-process-1", prcExeId)
+
+	_, err = client.StartProcess(ctx, "test-process", "state1", "process-1", "input", nil)
+	assert.True(t, xc.IsProcessAlreadyStartedError(err))
+
+	resp, err := client.DescribeCurrentProcessExecution(ctx, "process-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "test-process", resp.GetProcessType())
+	assert.Equal(t, xc.DefaultWorkerUrl, resp.GetWorkerUrl())
+	assert.Equal(t, xcapi.RUNNING, resp.GetStatus())
+
+	err = client.PublishToLocalQueue(ctx, "process-1", []xcapi.LocalQueueMessage{
+		{QueueName: "q1"},
+		{QueueName: "q2"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(server.messages["process-1"]))
+
+	err = client.StopProcess(ctx, "process-1", xcapi.TERMINATE)
+	assert.Nil(t, err)
+	resp, err = client.DescribeCurrentProcessExecution(ctx, "process-1")
+	assert.Nil(t, err)
+	assert.Equal(t, xcapi.TERMINATED, resp.GetStatus())
+
+	_, err = client.DescribeCurrentProcessExecution(ctx, "process-2")
+	assert.True(t, xc.IsProcessNotExistsError(err))
+	assert.True(t, xc.IsClientError(err))
+}
+
+type grpcTestProcess struct {
+	xc.ProcessDefaults
+}
+
+func (p grpcTestProcess) GetAsyncStateSchema() xc.StateSchema {
+	return xc.NewStateSchema(grpcTestState{})
+}
+
+type grpcTestState struct {
+	xc.AsyncStateDefaults
+}
+
+func (s grpcTestState) WaitUntil(
+	ctx xc.Context, input xc.Object, communication xc.Communication,
+) (*xc.CommandRequest, error) {
+	return xc.AnyOf(xc.NewLocalQueueCommand("q1", 1)), nil
+}
+
+func (s grpcTestState) Execute(
+	ctx xc.Context, input xc.Object, commandResults xc.CommandResults, persistence xc.Persistence,
+	communication xc.Communication,
+) (*xc.StateDecision, error) {
+	var in string
+	input.Get(&in)
+	if in == "fail" {
+		return nil, xc.NewRetryableErrorWithDelay(errors.New("failed"), 3*time.Second)
+	}
+	return xc.DeadEnd, nil
+}
+
+func TestWorkerServiceOverGrpc(t *testing.T) {
+	registry := xc.NewRegistry()
+	assert.Nil(t, registry.AddProcess(grpcTestProcess{}))
+	conn := startGrpcServer(t, func(s *grpc.Server) {
+		RegisterWorkerService(s, xc.NewWorkerService(registry, nil))
+	})
+	client := NewWorkerServiceClient(conn)
+	ctx := context.Background()
+
+	reqContext := xcapi.Context{
+		ProcessId: "process-1",
+		Attempt:   xcapi.PtrInt32(1),
+	}
+	prcType := xc.GetFinalProcessType(grpcTestProcess{})
+	stateId := xc.GetFinalStateId(grpcTestState{})
+
+	waitUntilResp, err := client.AsyncStateWaitUntil(ctx, xcapi.AsyncStateWaitUntilRequest{
+		Context:     reqContext,
+		ProcessType: prcType,
+		StateId:     stateId,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "q1", waitUntilResp.CommandRequest.LocalQueueCommands[0].QueueName)
+
+	encodedInput, err := xc.GetDefaultObjectEncoder().Encode("ok")
+	assert.Nil(t, err)
+	executeResp, err := client.AsyncStateExecute(ctx, xcapi.AsyncStateExecuteRequest{
+		Context:     reqContext,
+		ProcessType: prcType,
+		StateId:     stateId,
+		StateInput:  encodedInput,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, xcapi.DEAD_END, executeResp.StateDecision.ThreadCloseDecision.GetCloseType())
+
+	encodedInput, err = xc.GetDefaultObjectEncoder().Encode("fail")
+	assert.Nil(t, err)
+	var trailer metadata.MD
+	_, err = client.AsyncStateExecute(ctx, xcapi.AsyncStateExecuteRequest{
+		Context:     reqContext,
+		ProcessType: prcType,
+		StateId:     stateId,
+		StateInput:  encodedInput,
+	}, grpc.Trailer(&trailer))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	errResp, ok := GetWorkerErrorResponse(err)
+	assert.True(t, ok)
+	assert.Equal(t, xc.WorkerErrorTypeRetryable, errResp.ErrorType)
+	assert.Equal(t, []string{"3"}, trailer.Get(RetryAfterTrailer))
+}
+
+func TestMessageRoundTrip(t *testing.T) {
+	input := &xcapi.EncodedObject{
+		Encoding: "golangJson",
+		Data:     `{"amount":1}`,
+	}
+	columns := []xcapi.AppDatabaseColumnValue{
+		{Column: "id", QueryValue: "'a'"},
+	}
+	stateConfig := &xcapi.AsyncStateConfig{
+		SkipWaitUntil:              xcapi.PtrBool(true),
+		WaitUntilApiTimeoutSeconds: xcapi.PtrInt32(3),
+		ExecuteApiTimeoutSeconds:   xcapi.PtrInt32(4),
+		ExecuteApiRetryPolicy: &xcapi.RetryPolicy{
+			InitialIntervalSeconds:         xcapi.PtrInt32(1),
+			BackoffCoefficient:             xcapi.PtrFloat32(2),
+			MaximumIntervalSeconds:         xcapi.PtrInt32(10),
+			MaximumAttempts:                xcapi.PtrInt32(5),
+			MaximumAttemptsDurationSeconds: xcapi.PtrInt32(60),
+		},
+		StateFailureRecoveryOptions: &xcapi.StateFailureRecoveryOptions{
+			Policy:                         xcapi.PROCEED_TO_CONFIGURED_STATE,
+			StateFailureProceedStateId:     xcapi.PtrString("state2"),
+			StateFailureProceedStateConfig: &xcapi.AsyncStateConfig{SkipWaitUntil: xcapi.PtrBool(true)},
+		},
+		AppDatabaseReadRequest: &xcapi.AppDatabaseReadRequest{
+			Tables: []xcapi.AppDatabaseTableReadRequest{
+				{TableName: xcapi.PtrString("t"), LockType: xcapi.SHARE_LOCK.Ptr(), Columns: []string{"c"}},
+			},
+		},
+		LoadLocalAttributesRequest: &xcapi.LoadLocalAttributesRequest{
+			KeysToLoadNoLock:   []string{"k1"},
+			KeysToLoadWithLock: []string{"k2"},
+			LockType:           xcapi.EXCLUSIVE_LOCK.Ptr(),
+		},
+	}
+
+	request := xcapi.AsyncStateExecuteRequest{
+		Context: xcapi.Context{
+			ProcessId:                   "process-1",
+			ProcessExecutionId:          "execution-1",
+			ProcessStartedTimestamp:     1705000000,
+			StateExecutionId:            xcapi.PtrString("state1-1"),
+			FirstAttemptTimestamp:       xcapi.PtrInt64(1705000001),
+			Attempt:                     xcapi.PtrInt32(2),
+			RecoverFromStateExecutionId: xcapi.PtrString("state0-1"),
+			RecoverFromApi:              xcapi.EXECUTE_API.Ptr(),
+		},
+		ProcessType: "test-process",
+		StateId:     "state1",
+		StateInput:  input,
+		CommandResults: &xcapi.CommandResults{
+			TimerResults: []xcapi.TimerResult{{Status: xcapi.COMPLETED_COMMAND}},
+			LocalQueueResults: []xcapi.LocalQueueResult{
+				{
+					Status:    xcapi.COMPLETED_COMMAND,
+					QueueName: "q",
+					Messages:  []xcapi.LocalQueueMessageResult{{DedupId: "d", Payload: input}},
+				},
+			},
+		},
+		AppDatabaseReadResponse: &xcapi.AppDatabaseReadResponse{
+			Tables: []xcapi.AppDatabaseTableReadResponse{
+				{
+					TableName: xcapi.PtrString("t"),
+					Rows:      []xcapi.AppDatabaseRowReadResponse{{Columns: columns}},
+				},
+			},
+		},
+		AppDatabaseError: &xcapi.AppDatabaseError{
+			AppDBErrorType:      xcapi.APP_DATABASE_WRITE_ERROR,
+			AppDBErrorCode:      "23505",
+			AppDBErrorMessage:   xcapi.PtrString("duplicate key"),
+			AppDBErrorTableName: xcapi.PtrString("t"),
+		},
+		LoadedLocalAttributes: &xcapi.LoadLocalAttributesResponse{
+			Attributes: []xcapi.KeyValue{{Key: "k1", Value: *input}},
+		},
+	}
+	parsedRequest := &xcapi.AsyncStateExecuteRequest{}
+	roundTrip(t, toPbAsyncStateExecuteRequest(&request), func(m *xcpb.AsyncStateExecuteRequest) {
+		parsedRequest = fromPbAsyncStateExecuteRequest(m)
+	})
+	assert.Equal(t, request, *parsedRequest)
+
+	response := xcapi.AsyncStateExecuteResponse{
+		StateDecision: xcapi.StateDecision{
+			NextStates: []xcapi.StateMovement{
+				{StateId: "state2", StateInput: input, StateConfig: stateConfig},
+			},
+			ThreadCloseDecision: &xcapi.ThreadCloseDecision{
+				CloseType:  xcapi.FORCE_COMPLETE_PROCESS,
+				CloseInput: input,
+			},
+		},
+		PublishToLocalQueue: []xcapi.LocalQueueMessage{
+			{QueueName: "q", DedupId: xcapi.PtrString("d"), Payload: input},
+		},
+		WriteToAppDatabase: &xcapi.AppDatabaseWrite{
+			Tables: []xcapi.AppDatabaseTableWrite{
+				{
+					TableName: "t",
+					Rows:      []xcapi.AppDatabaseRowWrite{{PrimaryKey: columns, WriteColumns: columns}},
+				},
+			},
+		},
+		WriteToLocalAttributes: []xcapi.KeyValue{{Key: "k1", Value: *input}},
+	}
+	parsedResponse := &xcapi.AsyncStateExecuteResponse{}
+	roundTrip(t, toPbAsyncStateExecuteResponse(&response), func(m *xcpb.AsyncStateExecuteResponse) {
+		parsedResponse = fromPbAsyncStateExecuteResponse(m)
+	})
+	assert.Equal(t, response, *parsedResponse)
+
+	startRequest := xcapi.ProcessExecutionStartRequest{
+		Namespace:        "default",
+		ProcessId:        "process-1",
+		ProcessType:      "test-process",
+		WorkerUrl:        "http://localhost:8803",
+		StartStateId:     xcapi.PtrString("state1"),
+		StartStateInput:  input,
+		StartStateConfig: stateConfig,
+		ProcessStartConfig: &xcapi.ProcessStartConfig{
+			TimeoutSeconds: xcapi.PtrInt32(100),
+			IdReusePolicy:  xcapi.ALLOW_IF_NO_RUNNING.Ptr(),
+			AppDatabaseConfig: &xcapi.AppDatabaseConfig{
+				Tables: []xcapi.AppDatabaseTableConfig{
+					{
+						TableName: "t",
+						Rows: []xcapi.AppDatabaseTableRowSelector{
+							{PrimaryKey: columns, InitialWrite: columns, ConflictMode: xcapi.OVERRIDE_ON_CONFLICT.Ptr()},
+						},
+					},
+				},
+			},
+			LocalAttributeConfig: &xcapi.LocalAttributeConfig{
+				InitialWrite: []xcapi.KeyValue{{Key: "k1", Value: *input}},
+			},
+		},
+	}
+	parsedStartRequest := &xcapi.ProcessExecutionStartRequest{}
+	roundTrip(t, toPbProcessExecutionStartRequest(&startRequest), func(m *xcpb.ProcessExecutionStartRequest) {
+		parsedStartRequest = fromPbProcessExecutionStartRequest(m)
+	})
+	assert.Equal(t, startRequest, *parsedStartRequest)
+}
+
+// roundTrip marshals the message into the wire format, and unmarshals it into a new message for the check
+func roundTrip[M proto.Message](t *testing.T, msg M, check func(M)) {
+	data, err := proto.Marshal(msg)
+	assert.Nil(t, err)
+	decoded := msg.ProtoReflect().New().Interface().(M)
+	assert.Nil(t, proto.Unmarshal(data, decoded))
+	check(decoded)
+}
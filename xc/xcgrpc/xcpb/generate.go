@@ -0,0 +1,4 @@
+// Package xcpb is the generated code of xcherry.proto, see package xcgrpc to use it with the xcapi models
+package xcpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative xcherry.proto
@@ -0,0 +1,4047 @@
+// The gRPC services of xCherry, mirroring the REST APIs of the server and the worker.
+// Each message mirrors the xCherry API model of the same name, see https://github.com/xcherryio/apis.
+// The fields of the enums of the models(e.g. ProcessStatus) are the strings of the enum values.
+// See package xcgrpc for the Go implementation and the mapping to and from the xcapi models.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.23.4
+// source: xcherry.proto
+
+package xcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProcessExecutionStartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace          string              `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ProcessId          string              `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	ProcessType        string              `protobuf:"bytes,3,opt,name=process_type,json=processType,proto3" json:"process_type,omitempty"`
+	WorkerUrl          string              `protobuf:"bytes,4,opt,name=worker_url,json=workerUrl,proto3" json:"worker_url,omitempty"`
+	StartStateId       *string             `protobuf:"bytes,5,opt,name=start_state_id,json=startStateId,proto3,oneof" json:"start_state_id,omitempty"`
+	StartStateInput    *EncodedObject      `protobuf:"bytes,6,opt,name=start_state_input,json=startStateInput,proto3" json:"start_state_input,omitempty"`
+	StartStateConfig   *AsyncStateConfig   `protobuf:"bytes,7,opt,name=start_state_config,json=startStateConfig,proto3" json:"start_state_config,omitempty"`
+	ProcessStartConfig *ProcessStartConfig `protobuf:"bytes,8,opt,name=process_start_config,json=processStartConfig,proto3" json:"process_start_config,omitempty"`
+}
+
+func (x *ProcessExecutionStartRequest) Reset() {
+	*x = ProcessExecutionStartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessExecutionStartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessExecutionStartRequest) ProtoMessage() {}
+
+func (x *ProcessExecutionStartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessExecutionStartRequest.ProtoReflect.Descriptor instead.
+func (*ProcessExecutionStartRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProcessExecutionStartRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ProcessExecutionStartRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *ProcessExecutionStartRequest) GetProcessType() string {
+	if x != nil {
+		return x.ProcessType
+	}
+	return ""
+}
+
+func (x *ProcessExecutionStartRequest) GetWorkerUrl() string {
+	if x != nil {
+		return x.WorkerUrl
+	}
+	return ""
+}
+
+func (x *ProcessExecutionStartRequest) GetStartStateId() string {
+	if x != nil && x.StartStateId != nil {
+		return *x.StartStateId
+	}
+	return ""
+}
+
+func (x *ProcessExecutionStartRequest) GetStartStateInput() *EncodedObject {
+	if x != nil {
+		return x.StartStateInput
+	}
+	return nil
+}
+
+func (x *ProcessExecutionStartRequest) GetStartStateConfig() *AsyncStateConfig {
+	if x != nil {
+		return x.StartStateConfig
+	}
+	return nil
+}
+
+func (x *ProcessExecutionStartRequest) GetProcessStartConfig() *ProcessStartConfig {
+	if x != nil {
+		return x.ProcessStartConfig
+	}
+	return nil
+}
+
+type ProcessExecutionStartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessExecutionId string `protobuf:"bytes,1,opt,name=process_execution_id,json=processExecutionId,proto3" json:"process_execution_id,omitempty"`
+}
+
+func (x *ProcessExecutionStartResponse) Reset() {
+	*x = ProcessExecutionStartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessExecutionStartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessExecutionStartResponse) ProtoMessage() {}
+
+func (x *ProcessExecutionStartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessExecutionStartResponse.ProtoReflect.Descriptor instead.
+func (*ProcessExecutionStartResponse) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProcessExecutionStartResponse) GetProcessExecutionId() string {
+	if x != nil {
+		return x.ProcessExecutionId
+	}
+	return ""
+}
+
+type ProcessExecutionStopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ProcessId string `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	// ProcessExecutionStopType
+	StopType *string `protobuf:"bytes,3,opt,name=stop_type,json=stopType,proto3,oneof" json:"stop_type,omitempty"`
+}
+
+func (x *ProcessExecutionStopRequest) Reset() {
+	*x = ProcessExecutionStopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessExecutionStopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessExecutionStopRequest) ProtoMessage() {}
+
+func (x *ProcessExecutionStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessExecutionStopRequest.ProtoReflect.Descriptor instead.
+func (*ProcessExecutionStopRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProcessExecutionStopRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ProcessExecutionStopRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *ProcessExecutionStopRequest) GetStopType() string {
+	if x != nil && x.StopType != nil {
+		return *x.StopType
+	}
+	return ""
+}
+
+type ProcessExecutionDescribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ProcessId string `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+}
+
+func (x *ProcessExecutionDescribeRequest) Reset() {
+	*x = ProcessExecutionDescribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessExecutionDescribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessExecutionDescribeRequest) ProtoMessage() {}
+
+func (x *ProcessExecutionDescribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessExecutionDescribeRequest.ProtoReflect.Descriptor instead.
+func (*ProcessExecutionDescribeRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProcessExecutionDescribeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ProcessExecutionDescribeRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+type ProcessExecutionDescribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessExecutionId *string `protobuf:"bytes,1,opt,name=process_execution_id,json=processExecutionId,proto3,oneof" json:"process_execution_id,omitempty"`
+	ProcessType        *string `protobuf:"bytes,2,opt,name=process_type,json=processType,proto3,oneof" json:"process_type,omitempty"`
+	WorkerUrl          *string `protobuf:"bytes,3,opt,name=worker_url,json=workerUrl,proto3,oneof" json:"worker_url,omitempty"`
+	StartTimestamp     *int32  `protobuf:"varint,4,opt,name=start_timestamp,json=startTimestamp,proto3,oneof" json:"start_timestamp,omitempty"`
+	// ProcessStatus
+	Status *string `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"`
+}
+
+func (x *ProcessExecutionDescribeResponse) Reset() {
+	*x = ProcessExecutionDescribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessExecutionDescribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessExecutionDescribeResponse) ProtoMessage() {}
+
+func (x *ProcessExecutionDescribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessExecutionDescribeResponse.ProtoReflect.Descriptor instead.
+func (*ProcessExecutionDescribeResponse) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProcessExecutionDescribeResponse) GetProcessExecutionId() string {
+	if x != nil && x.ProcessExecutionId != nil {
+		return *x.ProcessExecutionId
+	}
+	return ""
+}
+
+func (x *ProcessExecutionDescribeResponse) GetProcessType() string {
+	if x != nil && x.ProcessType != nil {
+		return *x.ProcessType
+	}
+	return ""
+}
+
+func (x *ProcessExecutionDescribeResponse) GetWorkerUrl() string {
+	if x != nil && x.WorkerUrl != nil {
+		return *x.WorkerUrl
+	}
+	return ""
+}
+
+func (x *ProcessExecutionDescribeResponse) GetStartTimestamp() int32 {
+	if x != nil && x.StartTimestamp != nil {
+		return *x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *ProcessExecutionDescribeResponse) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type PublishToLocalQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string               `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ProcessId string               `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	Messages  []*LocalQueueMessage `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *PublishToLocalQueueRequest) Reset() {
+	*x = PublishToLocalQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishToLocalQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishToLocalQueueRequest) ProtoMessage() {}
+
+func (x *PublishToLocalQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishToLocalQueueRequest.ProtoReflect.Descriptor instead.
+func (*PublishToLocalQueueRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PublishToLocalQueueRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PublishToLocalQueueRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *PublishToLocalQueueRequest) GetMessages() []*LocalQueueMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type AsyncStateWaitUntilRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context     *Context       `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	ProcessType string         `protobuf:"bytes,2,opt,name=process_type,json=processType,proto3" json:"process_type,omitempty"`
+	StateId     string         `protobuf:"bytes,3,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	StateInput  *EncodedObject `protobuf:"bytes,4,opt,name=state_input,json=stateInput,proto3" json:"state_input,omitempty"`
+}
+
+func (x *AsyncStateWaitUntilRequest) Reset() {
+	*x = AsyncStateWaitUntilRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncStateWaitUntilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncStateWaitUntilRequest) ProtoMessage() {}
+
+func (x *AsyncStateWaitUntilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncStateWaitUntilRequest.ProtoReflect.Descriptor instead.
+func (*AsyncStateWaitUntilRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AsyncStateWaitUntilRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *AsyncStateWaitUntilRequest) GetProcessType() string {
+	if x != nil {
+		return x.ProcessType
+	}
+	return ""
+}
+
+func (x *AsyncStateWaitUntilRequest) GetStateId() string {
+	if x != nil {
+		return x.StateId
+	}
+	return ""
+}
+
+func (x *AsyncStateWaitUntilRequest) GetStateInput() *EncodedObject {
+	if x != nil {
+		return x.StateInput
+	}
+	return nil
+}
+
+type AsyncStateWaitUntilResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandRequest      *CommandRequest      `protobuf:"bytes,1,opt,name=command_request,json=commandRequest,proto3" json:"command_request,omitempty"`
+	PublishToLocalQueue []*LocalQueueMessage `protobuf:"bytes,2,rep,name=publish_to_local_queue,json=publishToLocalQueue,proto3" json:"publish_to_local_queue,omitempty"`
+}
+
+func (x *AsyncStateWaitUntilResponse) Reset() {
+	*x = AsyncStateWaitUntilResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncStateWaitUntilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncStateWaitUntilResponse) ProtoMessage() {}
+
+func (x *AsyncStateWaitUntilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncStateWaitUntilResponse.ProtoReflect.Descriptor instead.
+func (*AsyncStateWaitUntilResponse) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AsyncStateWaitUntilResponse) GetCommandRequest() *CommandRequest {
+	if x != nil {
+		return x.CommandRequest
+	}
+	return nil
+}
+
+func (x *AsyncStateWaitUntilResponse) GetPublishToLocalQueue() []*LocalQueueMessage {
+	if x != nil {
+		return x.PublishToLocalQueue
+	}
+	return nil
+}
+
+type AsyncStateExecuteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context                 *Context                     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	ProcessType             string                       `protobuf:"bytes,2,opt,name=process_type,json=processType,proto3" json:"process_type,omitempty"`
+	StateId                 string                       `protobuf:"bytes,3,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	StateInput              *EncodedObject               `protobuf:"bytes,4,opt,name=state_input,json=stateInput,proto3" json:"state_input,omitempty"`
+	CommandResults          *CommandResults              `protobuf:"bytes,5,opt,name=command_results,json=commandResults,proto3" json:"command_results,omitempty"`
+	AppDatabaseReadResponse *AppDatabaseReadResponse     `protobuf:"bytes,6,opt,name=app_database_read_response,json=appDatabaseReadResponse,proto3" json:"app_database_read_response,omitempty"`
+	AppDatabaseError        *AppDatabaseError            `protobuf:"bytes,7,opt,name=app_database_error,json=appDatabaseError,proto3" json:"app_database_error,omitempty"`
+	LoadedLocalAttributes   *LoadLocalAttributesResponse `protobuf:"bytes,8,opt,name=loaded_local_attributes,json=loadedLocalAttributes,proto3" json:"loaded_local_attributes,omitempty"`
+}
+
+func (x *AsyncStateExecuteRequest) Reset() {
+	*x = AsyncStateExecuteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncStateExecuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncStateExecuteRequest) ProtoMessage() {}
+
+func (x *AsyncStateExecuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncStateExecuteRequest.ProtoReflect.Descriptor instead.
+func (*AsyncStateExecuteRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AsyncStateExecuteRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *AsyncStateExecuteRequest) GetProcessType() string {
+	if x != nil {
+		return x.ProcessType
+	}
+	return ""
+}
+
+func (x *AsyncStateExecuteRequest) GetStateId() string {
+	if x != nil {
+		return x.StateId
+	}
+	return ""
+}
+
+func (x *AsyncStateExecuteRequest) GetStateInput() *EncodedObject {
+	if x != nil {
+		return x.StateInput
+	}
+	return nil
+}
+
+func (x *AsyncStateExecuteRequest) GetCommandResults() *CommandResults {
+	if x != nil {
+		return x.CommandResults
+	}
+	return nil
+}
+
+func (x *AsyncStateExecuteRequest) GetAppDatabaseReadResponse() *AppDatabaseReadResponse {
+	if x != nil {
+		return x.AppDatabaseReadResponse
+	}
+	return nil
+}
+
+func (x *AsyncStateExecuteRequest) GetAppDatabaseError() *AppDatabaseError {
+	if x != nil {
+		return x.AppDatabaseError
+	}
+	return nil
+}
+
+func (x *AsyncStateExecuteRequest) GetLoadedLocalAttributes() *LoadLocalAttributesResponse {
+	if x != nil {
+		return x.LoadedLocalAttributes
+	}
+	return nil
+}
+
+type AsyncStateExecuteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StateDecision          *StateDecision       `protobuf:"bytes,1,opt,name=state_decision,json=stateDecision,proto3" json:"state_decision,omitempty"`
+	PublishToLocalQueue    []*LocalQueueMessage `protobuf:"bytes,2,rep,name=publish_to_local_queue,json=publishToLocalQueue,proto3" json:"publish_to_local_queue,omitempty"`
+	WriteToAppDatabase     *AppDatabaseWrite    `protobuf:"bytes,3,opt,name=write_to_app_database,json=writeToAppDatabase,proto3" json:"write_to_app_database,omitempty"`
+	WriteToLocalAttributes []*KeyValue          `protobuf:"bytes,4,rep,name=write_to_local_attributes,json=writeToLocalAttributes,proto3" json:"write_to_local_attributes,omitempty"`
+}
+
+func (x *AsyncStateExecuteResponse) Reset() {
+	*x = AsyncStateExecuteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncStateExecuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncStateExecuteResponse) ProtoMessage() {}
+
+func (x *AsyncStateExecuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncStateExecuteResponse.ProtoReflect.Descriptor instead.
+func (*AsyncStateExecuteResponse) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AsyncStateExecuteResponse) GetStateDecision() *StateDecision {
+	if x != nil {
+		return x.StateDecision
+	}
+	return nil
+}
+
+func (x *AsyncStateExecuteResponse) GetPublishToLocalQueue() []*LocalQueueMessage {
+	if x != nil {
+		return x.PublishToLocalQueue
+	}
+	return nil
+}
+
+func (x *AsyncStateExecuteResponse) GetWriteToAppDatabase() *AppDatabaseWrite {
+	if x != nil {
+		return x.WriteToAppDatabase
+	}
+	return nil
+}
+
+func (x *AsyncStateExecuteResponse) GetWriteToLocalAttributes() []*KeyValue {
+	if x != nil {
+		return x.WriteToLocalAttributes
+	}
+	return nil
+}
+
+type EncodedObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Encoding string `protobuf:"bytes,1,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	Data     string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *EncodedObject) Reset() {
+	*x = EncodedObject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodedObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodedObject) ProtoMessage() {}
+
+func (x *EncodedObject) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodedObject.ProtoReflect.Descriptor instead.
+func (*EncodedObject) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EncodedObject) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *EncodedObject) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type KeyValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *EncodedObject `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *KeyValue) Reset() {
+	*x = KeyValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyValue) ProtoMessage() {}
+
+func (x *KeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyValue.ProtoReflect.Descriptor instead.
+func (*KeyValue) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *KeyValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyValue) GetValue() *EncodedObject {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type Context struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessId                   string  `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	ProcessExecutionId          string  `protobuf:"bytes,2,opt,name=process_execution_id,json=processExecutionId,proto3" json:"process_execution_id,omitempty"`
+	ProcessStartedTimestamp     int64   `protobuf:"varint,3,opt,name=process_started_timestamp,json=processStartedTimestamp,proto3" json:"process_started_timestamp,omitempty"`
+	StateExecutionId            *string `protobuf:"bytes,4,opt,name=state_execution_id,json=stateExecutionId,proto3,oneof" json:"state_execution_id,omitempty"`
+	FirstAttemptTimestamp       *int64  `protobuf:"varint,5,opt,name=first_attempt_timestamp,json=firstAttemptTimestamp,proto3,oneof" json:"first_attempt_timestamp,omitempty"`
+	Attempt                     *int32  `protobuf:"varint,6,opt,name=attempt,proto3,oneof" json:"attempt,omitempty"`
+	RecoverFromStateExecutionId *string `protobuf:"bytes,7,opt,name=recover_from_state_execution_id,json=recoverFromStateExecutionId,proto3,oneof" json:"recover_from_state_execution_id,omitempty"`
+	// WorkerApiType
+	RecoverFromApi *string `protobuf:"bytes,8,opt,name=recover_from_api,json=recoverFromApi,proto3,oneof" json:"recover_from_api,omitempty"`
+}
+
+func (x *Context) Reset() {
+	*x = Context{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Context) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Context) ProtoMessage() {}
+
+func (x *Context) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Context.ProtoReflect.Descriptor instead.
+func (*Context) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Context) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *Context) GetProcessExecutionId() string {
+	if x != nil {
+		return x.ProcessExecutionId
+	}
+	return ""
+}
+
+func (x *Context) GetProcessStartedTimestamp() int64 {
+	if x != nil {
+		return x.ProcessStartedTimestamp
+	}
+	return 0
+}
+
+func (x *Context) GetStateExecutionId() string {
+	if x != nil && x.StateExecutionId != nil {
+		return *x.StateExecutionId
+	}
+	return ""
+}
+
+func (x *Context) GetFirstAttemptTimestamp() int64 {
+	if x != nil && x.FirstAttemptTimestamp != nil {
+		return *x.FirstAttemptTimestamp
+	}
+	return 0
+}
+
+func (x *Context) GetAttempt() int32 {
+	if x != nil && x.Attempt != nil {
+		return *x.Attempt
+	}
+	return 0
+}
+
+func (x *Context) GetRecoverFromStateExecutionId() string {
+	if x != nil && x.RecoverFromStateExecutionId != nil {
+		return *x.RecoverFromStateExecutionId
+	}
+	return ""
+}
+
+func (x *Context) GetRecoverFromApi() string {
+	if x != nil && x.RecoverFromApi != nil {
+		return *x.RecoverFromApi
+	}
+	return ""
+}
+
+type AsyncStateConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SkipWaitUntil               *bool                        `protobuf:"varint,1,opt,name=skip_wait_until,json=skipWaitUntil,proto3,oneof" json:"skip_wait_until,omitempty"`
+	WaitUntilApiTimeoutSeconds  *int32                       `protobuf:"varint,2,opt,name=wait_until_api_timeout_seconds,json=waitUntilApiTimeoutSeconds,proto3,oneof" json:"wait_until_api_timeout_seconds,omitempty"`
+	ExecuteApiTimeoutSeconds    *int32                       `protobuf:"varint,3,opt,name=execute_api_timeout_seconds,json=executeApiTimeoutSeconds,proto3,oneof" json:"execute_api_timeout_seconds,omitempty"`
+	WaitUntilApiRetryPolicy     *RetryPolicy                 `protobuf:"bytes,4,opt,name=wait_until_api_retry_policy,json=waitUntilApiRetryPolicy,proto3" json:"wait_until_api_retry_policy,omitempty"`
+	ExecuteApiRetryPolicy       *RetryPolicy                 `protobuf:"bytes,5,opt,name=execute_api_retry_policy,json=executeApiRetryPolicy,proto3" json:"execute_api_retry_policy,omitempty"`
+	StateFailureRecoveryOptions *StateFailureRecoveryOptions `protobuf:"bytes,6,opt,name=state_failure_recovery_options,json=stateFailureRecoveryOptions,proto3" json:"state_failure_recovery_options,omitempty"`
+	AppDatabaseReadRequest      *AppDatabaseReadRequest      `protobuf:"bytes,7,opt,name=app_database_read_request,json=appDatabaseReadRequest,proto3" json:"app_database_read_request,omitempty"`
+	LoadLocalAttributesRequest  *LoadLocalAttributesRequest  `protobuf:"bytes,8,opt,name=load_local_attributes_request,json=loadLocalAttributesRequest,proto3" json:"load_local_attributes_request,omitempty"`
+}
+
+func (x *AsyncStateConfig) Reset() {
+	*x = AsyncStateConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncStateConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncStateConfig) ProtoMessage() {}
+
+func (x *AsyncStateConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncStateConfig.ProtoReflect.Descriptor instead.
+func (*AsyncStateConfig) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AsyncStateConfig) GetSkipWaitUntil() bool {
+	if x != nil && x.SkipWaitUntil != nil {
+		return *x.SkipWaitUntil
+	}
+	return false
+}
+
+func (x *AsyncStateConfig) GetWaitUntilApiTimeoutSeconds() int32 {
+	if x != nil && x.WaitUntilApiTimeoutSeconds != nil {
+		return *x.WaitUntilApiTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *AsyncStateConfig) GetExecuteApiTimeoutSeconds() int32 {
+	if x != nil && x.ExecuteApiTimeoutSeconds != nil {
+		return *x.ExecuteApiTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *AsyncStateConfig) GetWaitUntilApiRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.WaitUntilApiRetryPolicy
+	}
+	return nil
+}
+
+func (x *AsyncStateConfig) GetExecuteApiRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.ExecuteApiRetryPolicy
+	}
+	return nil
+}
+
+func (x *AsyncStateConfig) GetStateFailureRecoveryOptions() *StateFailureRecoveryOptions {
+	if x != nil {
+		return x.StateFailureRecoveryOptions
+	}
+	return nil
+}
+
+func (x *AsyncStateConfig) GetAppDatabaseReadRequest() *AppDatabaseReadRequest {
+	if x != nil {
+		return x.AppDatabaseReadRequest
+	}
+	return nil
+}
+
+func (x *AsyncStateConfig) GetLoadLocalAttributesRequest() *LoadLocalAttributesRequest {
+	if x != nil {
+		return x.LoadLocalAttributesRequest
+	}
+	return nil
+}
+
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitialIntervalSeconds         *int32   `protobuf:"varint,1,opt,name=initial_interval_seconds,json=initialIntervalSeconds,proto3,oneof" json:"initial_interval_seconds,omitempty"`
+	BackoffCoefficient             *float32 `protobuf:"fixed32,2,opt,name=backoff_coefficient,json=backoffCoefficient,proto3,oneof" json:"backoff_coefficient,omitempty"`
+	MaximumIntervalSeconds         *int32   `protobuf:"varint,3,opt,name=maximum_interval_seconds,json=maximumIntervalSeconds,proto3,oneof" json:"maximum_interval_seconds,omitempty"`
+	MaximumAttempts                *int32   `protobuf:"varint,4,opt,name=maximum_attempts,json=maximumAttempts,proto3,oneof" json:"maximum_attempts,omitempty"`
+	MaximumAttemptsDurationSeconds *int32   `protobuf:"varint,5,opt,name=maximum_attempts_duration_seconds,json=maximumAttemptsDurationSeconds,proto3,oneof" json:"maximum_attempts_duration_seconds,omitempty"`
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RetryPolicy) GetInitialIntervalSeconds() int32 {
+	if x != nil && x.InitialIntervalSeconds != nil {
+		return *x.InitialIntervalSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffCoefficient() float32 {
+	if x != nil && x.BackoffCoefficient != nil {
+		return *x.BackoffCoefficient
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaximumIntervalSeconds() int32 {
+	if x != nil && x.MaximumIntervalSeconds != nil {
+		return *x.MaximumIntervalSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaximumAttempts() int32 {
+	if x != nil && x.MaximumAttempts != nil {
+		return *x.MaximumAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaximumAttemptsDurationSeconds() int32 {
+	if x != nil && x.MaximumAttemptsDurationSeconds != nil {
+		return *x.MaximumAttemptsDurationSeconds
+	}
+	return 0
+}
+
+type StateFailureRecoveryOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// StateFailureRecoveryPolicy
+	Policy                         string            `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	StateFailureProceedStateId     *string           `protobuf:"bytes,2,opt,name=state_failure_proceed_state_id,json=stateFailureProceedStateId,proto3,oneof" json:"state_failure_proceed_state_id,omitempty"`
+	StateFailureProceedStateConfig *AsyncStateConfig `protobuf:"bytes,3,opt,name=state_failure_proceed_state_config,json=stateFailureProceedStateConfig,proto3" json:"state_failure_proceed_state_config,omitempty"`
+}
+
+func (x *StateFailureRecoveryOptions) Reset() {
+	*x = StateFailureRecoveryOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateFailureRecoveryOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateFailureRecoveryOptions) ProtoMessage() {}
+
+func (x *StateFailureRecoveryOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateFailureRecoveryOptions.ProtoReflect.Descriptor instead.
+func (*StateFailureRecoveryOptions) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StateFailureRecoveryOptions) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *StateFailureRecoveryOptions) GetStateFailureProceedStateId() string {
+	if x != nil && x.StateFailureProceedStateId != nil {
+		return *x.StateFailureProceedStateId
+	}
+	return ""
+}
+
+func (x *StateFailureRecoveryOptions) GetStateFailureProceedStateConfig() *AsyncStateConfig {
+	if x != nil {
+		return x.StateFailureProceedStateConfig
+	}
+	return nil
+}
+
+type ProcessStartConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeoutSeconds *int32 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	// ProcessIdReusePolicy
+	IdReusePolicy        *string               `protobuf:"bytes,2,opt,name=id_reuse_policy,json=idReusePolicy,proto3,oneof" json:"id_reuse_policy,omitempty"`
+	AppDatabaseConfig    *AppDatabaseConfig    `protobuf:"bytes,3,opt,name=app_database_config,json=appDatabaseConfig,proto3" json:"app_database_config,omitempty"`
+	LocalAttributeConfig *LocalAttributeConfig `protobuf:"bytes,4,opt,name=local_attribute_config,json=localAttributeConfig,proto3" json:"local_attribute_config,omitempty"`
+}
+
+func (x *ProcessStartConfig) Reset() {
+	*x = ProcessStartConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessStartConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessStartConfig) ProtoMessage() {}
+
+func (x *ProcessStartConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessStartConfig.ProtoReflect.Descriptor instead.
+func (*ProcessStartConfig) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProcessStartConfig) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ProcessStartConfig) GetIdReusePolicy() string {
+	if x != nil && x.IdReusePolicy != nil {
+		return *x.IdReusePolicy
+	}
+	return ""
+}
+
+func (x *ProcessStartConfig) GetAppDatabaseConfig() *AppDatabaseConfig {
+	if x != nil {
+		return x.AppDatabaseConfig
+	}
+	return nil
+}
+
+func (x *ProcessStartConfig) GetLocalAttributeConfig() *LocalAttributeConfig {
+	if x != nil {
+		return x.LocalAttributeConfig
+	}
+	return nil
+}
+
+type LocalAttributeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitialWrite []*KeyValue `protobuf:"bytes,1,rep,name=initial_write,json=initialWrite,proto3" json:"initial_write,omitempty"`
+}
+
+func (x *LocalAttributeConfig) Reset() {
+	*x = LocalAttributeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalAttributeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalAttributeConfig) ProtoMessage() {}
+
+func (x *LocalAttributeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalAttributeConfig.ProtoReflect.Descriptor instead.
+func (*LocalAttributeConfig) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LocalAttributeConfig) GetInitialWrite() []*KeyValue {
+	if x != nil {
+		return x.InitialWrite
+	}
+	return nil
+}
+
+type LoadLocalAttributesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeysToLoadNoLock   []string `protobuf:"bytes,1,rep,name=keys_to_load_no_lock,json=keysToLoadNoLock,proto3" json:"keys_to_load_no_lock,omitempty"`
+	KeysToLoadWithLock []string `protobuf:"bytes,2,rep,name=keys_to_load_with_lock,json=keysToLoadWithLock,proto3" json:"keys_to_load_with_lock,omitempty"`
+	// LockType
+	LockType *string `protobuf:"bytes,3,opt,name=lock_type,json=lockType,proto3,oneof" json:"lock_type,omitempty"`
+}
+
+func (x *LoadLocalAttributesRequest) Reset() {
+	*x = LoadLocalAttributesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadLocalAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadLocalAttributesRequest) ProtoMessage() {}
+
+func (x *LoadLocalAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadLocalAttributesRequest.ProtoReflect.Descriptor instead.
+func (*LoadLocalAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LoadLocalAttributesRequest) GetKeysToLoadNoLock() []string {
+	if x != nil {
+		return x.KeysToLoadNoLock
+	}
+	return nil
+}
+
+func (x *LoadLocalAttributesRequest) GetKeysToLoadWithLock() []string {
+	if x != nil {
+		return x.KeysToLoadWithLock
+	}
+	return nil
+}
+
+func (x *LoadLocalAttributesRequest) GetLockType() string {
+	if x != nil && x.LockType != nil {
+		return *x.LockType
+	}
+	return ""
+}
+
+type LoadLocalAttributesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attributes []*KeyValue `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *LoadLocalAttributesResponse) Reset() {
+	*x = LoadLocalAttributesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadLocalAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadLocalAttributesResponse) ProtoMessage() {}
+
+func (x *LoadLocalAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadLocalAttributesResponse.ProtoReflect.Descriptor instead.
+func (*LoadLocalAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LoadLocalAttributesResponse) GetAttributes() []*KeyValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type LocalQueueMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueueName string         `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
+	DedupId   *string        `protobuf:"bytes,2,opt,name=dedup_id,json=dedupId,proto3,oneof" json:"dedup_id,omitempty"`
+	Payload   *EncodedObject `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *LocalQueueMessage) Reset() {
+	*x = LocalQueueMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalQueueMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalQueueMessage) ProtoMessage() {}
+
+func (x *LocalQueueMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalQueueMessage.ProtoReflect.Descriptor instead.
+func (*LocalQueueMessage) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LocalQueueMessage) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
+func (x *LocalQueueMessage) GetDedupId() string {
+	if x != nil && x.DedupId != nil {
+		return *x.DedupId
+	}
+	return ""
+}
+
+func (x *LocalQueueMessage) GetPayload() *EncodedObject {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type CommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CommandWaitingType
+	WaitingType        string               `protobuf:"bytes,1,opt,name=waiting_type,json=waitingType,proto3" json:"waiting_type,omitempty"`
+	TimerCommands      []*TimerCommand      `protobuf:"bytes,2,rep,name=timer_commands,json=timerCommands,proto3" json:"timer_commands,omitempty"`
+	LocalQueueCommands []*LocalQueueCommand `protobuf:"bytes,3,rep,name=local_queue_commands,json=localQueueCommands,proto3" json:"local_queue_commands,omitempty"`
+}
+
+func (x *CommandRequest) Reset() {
+	*x = CommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandRequest) ProtoMessage() {}
+
+func (x *CommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandRequest.ProtoReflect.Descriptor instead.
+func (*CommandRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CommandRequest) GetWaitingType() string {
+	if x != nil {
+		return x.WaitingType
+	}
+	return ""
+}
+
+func (x *CommandRequest) GetTimerCommands() []*TimerCommand {
+	if x != nil {
+		return x.TimerCommands
+	}
+	return nil
+}
+
+func (x *CommandRequest) GetLocalQueueCommands() []*LocalQueueCommand {
+	if x != nil {
+		return x.LocalQueueCommands
+	}
+	return nil
+}
+
+type TimerCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DelayInSeconds int64 `protobuf:"varint,1,opt,name=delay_in_seconds,json=delayInSeconds,proto3" json:"delay_in_seconds,omitempty"`
+}
+
+func (x *TimerCommand) Reset() {
+	*x = TimerCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimerCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerCommand) ProtoMessage() {}
+
+func (x *TimerCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerCommand.ProtoReflect.Descriptor instead.
+func (*TimerCommand) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TimerCommand) GetDelayInSeconds() int64 {
+	if x != nil {
+		return x.DelayInSeconds
+	}
+	return 0
+}
+
+type LocalQueueCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueueName string `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
+	Count     *int32 `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+}
+
+func (x *LocalQueueCommand) Reset() {
+	*x = LocalQueueCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalQueueCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalQueueCommand) ProtoMessage() {}
+
+func (x *LocalQueueCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalQueueCommand.ProtoReflect.Descriptor instead.
+func (*LocalQueueCommand) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LocalQueueCommand) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
+func (x *LocalQueueCommand) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type CommandResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimerResults      []*TimerResult      `protobuf:"bytes,1,rep,name=timer_results,json=timerResults,proto3" json:"timer_results,omitempty"`
+	LocalQueueResults []*LocalQueueResult `protobuf:"bytes,2,rep,name=local_queue_results,json=localQueueResults,proto3" json:"local_queue_results,omitempty"`
+}
+
+func (x *CommandResults) Reset() {
+	*x = CommandResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandResults) ProtoMessage() {}
+
+func (x *CommandResults) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandResults.ProtoReflect.Descriptor instead.
+func (*CommandResults) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CommandResults) GetTimerResults() []*TimerResult {
+	if x != nil {
+		return x.TimerResults
+	}
+	return nil
+}
+
+func (x *CommandResults) GetLocalQueueResults() []*LocalQueueResult {
+	if x != nil {
+		return x.LocalQueueResults
+	}
+	return nil
+}
+
+type TimerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CommandStatus
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *TimerResult) Reset() {
+	*x = TimerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerResult) ProtoMessage() {}
+
+func (x *TimerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerResult.ProtoReflect.Descriptor instead.
+func (*TimerResult) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TimerResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type LocalQueueResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CommandStatus
+	Status    string                     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	QueueName string                     `protobuf:"bytes,2,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
+	Messages  []*LocalQueueMessageResult `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *LocalQueueResult) Reset() {
+	*x = LocalQueueResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalQueueResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalQueueResult) ProtoMessage() {}
+
+func (x *LocalQueueResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalQueueResult.ProtoReflect.Descriptor instead.
+func (*LocalQueueResult) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LocalQueueResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LocalQueueResult) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
+func (x *LocalQueueResult) GetMessages() []*LocalQueueMessageResult {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type LocalQueueMessageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DedupId string         `protobuf:"bytes,1,opt,name=dedup_id,json=dedupId,proto3" json:"dedup_id,omitempty"`
+	Payload *EncodedObject `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *LocalQueueMessageResult) Reset() {
+	*x = LocalQueueMessageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalQueueMessageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalQueueMessageResult) ProtoMessage() {}
+
+func (x *LocalQueueMessageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalQueueMessageResult.ProtoReflect.Descriptor instead.
+func (*LocalQueueMessageResult) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LocalQueueMessageResult) GetDedupId() string {
+	if x != nil {
+		return x.DedupId
+	}
+	return ""
+}
+
+func (x *LocalQueueMessageResult) GetPayload() *EncodedObject {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type StateDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextStates          []*StateMovement     `protobuf:"bytes,1,rep,name=next_states,json=nextStates,proto3" json:"next_states,omitempty"`
+	ThreadCloseDecision *ThreadCloseDecision `protobuf:"bytes,2,opt,name=thread_close_decision,json=threadCloseDecision,proto3" json:"thread_close_decision,omitempty"`
+}
+
+func (x *StateDecision) Reset() {
+	*x = StateDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateDecision) ProtoMessage() {}
+
+func (x *StateDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateDecision.ProtoReflect.Descriptor instead.
+func (*StateDecision) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StateDecision) GetNextStates() []*StateMovement {
+	if x != nil {
+		return x.NextStates
+	}
+	return nil
+}
+
+func (x *StateDecision) GetThreadCloseDecision() *ThreadCloseDecision {
+	if x != nil {
+		return x.ThreadCloseDecision
+	}
+	return nil
+}
+
+type StateMovement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StateId     string            `protobuf:"bytes,1,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	StateInput  *EncodedObject    `protobuf:"bytes,2,opt,name=state_input,json=stateInput,proto3" json:"state_input,omitempty"`
+	StateConfig *AsyncStateConfig `protobuf:"bytes,3,opt,name=state_config,json=stateConfig,proto3" json:"state_config,omitempty"`
+}
+
+func (x *StateMovement) Reset() {
+	*x = StateMovement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateMovement) ProtoMessage() {}
+
+func (x *StateMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateMovement.ProtoReflect.Descriptor instead.
+func (*StateMovement) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StateMovement) GetStateId() string {
+	if x != nil {
+		return x.StateId
+	}
+	return ""
+}
+
+func (x *StateMovement) GetStateInput() *EncodedObject {
+	if x != nil {
+		return x.StateInput
+	}
+	return nil
+}
+
+func (x *StateMovement) GetStateConfig() *AsyncStateConfig {
+	if x != nil {
+		return x.StateConfig
+	}
+	return nil
+}
+
+type ThreadCloseDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ThreadCloseType
+	CloseType  string         `protobuf:"bytes,1,opt,name=close_type,json=closeType,proto3" json:"close_type,omitempty"`
+	CloseInput *EncodedObject `protobuf:"bytes,2,opt,name=close_input,json=closeInput,proto3" json:"close_input,omitempty"`
+}
+
+func (x *ThreadCloseDecision) Reset() {
+	*x = ThreadCloseDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadCloseDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadCloseDecision) ProtoMessage() {}
+
+func (x *ThreadCloseDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadCloseDecision.ProtoReflect.Descriptor instead.
+func (*ThreadCloseDecision) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ThreadCloseDecision) GetCloseType() string {
+	if x != nil {
+		return x.CloseType
+	}
+	return ""
+}
+
+func (x *ThreadCloseDecision) GetCloseInput() *EncodedObject {
+	if x != nil {
+		return x.CloseInput
+	}
+	return nil
+}
+
+type AppDatabaseConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tables []*AppDatabaseTableConfig `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *AppDatabaseConfig) Reset() {
+	*x = AppDatabaseConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseConfig) ProtoMessage() {}
+
+func (x *AppDatabaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseConfig.ProtoReflect.Descriptor instead.
+func (*AppDatabaseConfig) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AppDatabaseConfig) GetTables() []*AppDatabaseTableConfig {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type AppDatabaseTableConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableName string                         `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Rows      []*AppDatabaseTableRowSelector `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *AppDatabaseTableConfig) Reset() {
+	*x = AppDatabaseTableConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseTableConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseTableConfig) ProtoMessage() {}
+
+func (x *AppDatabaseTableConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseTableConfig.ProtoReflect.Descriptor instead.
+func (*AppDatabaseTableConfig) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AppDatabaseTableConfig) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *AppDatabaseTableConfig) GetRows() []*AppDatabaseTableRowSelector {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type AppDatabaseTableRowSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrimaryKey   []*AppDatabaseColumnValue `protobuf:"bytes,1,rep,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	InitialWrite []*AppDatabaseColumnValue `protobuf:"bytes,2,rep,name=initial_write,json=initialWrite,proto3" json:"initial_write,omitempty"`
+	// WriteConflictMode
+	ConflictMode *string `protobuf:"bytes,3,opt,name=conflict_mode,json=conflictMode,proto3,oneof" json:"conflict_mode,omitempty"`
+}
+
+func (x *AppDatabaseTableRowSelector) Reset() {
+	*x = AppDatabaseTableRowSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseTableRowSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseTableRowSelector) ProtoMessage() {}
+
+func (x *AppDatabaseTableRowSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseTableRowSelector.ProtoReflect.Descriptor instead.
+func (*AppDatabaseTableRowSelector) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AppDatabaseTableRowSelector) GetPrimaryKey() []*AppDatabaseColumnValue {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return nil
+}
+
+func (x *AppDatabaseTableRowSelector) GetInitialWrite() []*AppDatabaseColumnValue {
+	if x != nil {
+		return x.InitialWrite
+	}
+	return nil
+}
+
+func (x *AppDatabaseTableRowSelector) GetConflictMode() string {
+	if x != nil && x.ConflictMode != nil {
+		return *x.ConflictMode
+	}
+	return ""
+}
+
+type AppDatabaseColumnValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Column     string `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	QueryValue string `protobuf:"bytes,2,opt,name=query_value,json=queryValue,proto3" json:"query_value,omitempty"`
+}
+
+func (x *AppDatabaseColumnValue) Reset() {
+	*x = AppDatabaseColumnValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseColumnValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseColumnValue) ProtoMessage() {}
+
+func (x *AppDatabaseColumnValue) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseColumnValue.ProtoReflect.Descriptor instead.
+func (*AppDatabaseColumnValue) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AppDatabaseColumnValue) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *AppDatabaseColumnValue) GetQueryValue() string {
+	if x != nil {
+		return x.QueryValue
+	}
+	return ""
+}
+
+type AppDatabaseReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tables []*AppDatabaseTableReadRequest `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *AppDatabaseReadRequest) Reset() {
+	*x = AppDatabaseReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseReadRequest) ProtoMessage() {}
+
+func (x *AppDatabaseReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseReadRequest.ProtoReflect.Descriptor instead.
+func (*AppDatabaseReadRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AppDatabaseReadRequest) GetTables() []*AppDatabaseTableReadRequest {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type AppDatabaseTableReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableName *string `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3,oneof" json:"table_name,omitempty"`
+	// LockType
+	LockType *string  `protobuf:"bytes,2,opt,name=lock_type,json=lockType,proto3,oneof" json:"lock_type,omitempty"`
+	Columns  []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *AppDatabaseTableReadRequest) Reset() {
+	*x = AppDatabaseTableReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseTableReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseTableReadRequest) ProtoMessage() {}
+
+func (x *AppDatabaseTableReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseTableReadRequest.ProtoReflect.Descriptor instead.
+func (*AppDatabaseTableReadRequest) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AppDatabaseTableReadRequest) GetTableName() string {
+	if x != nil && x.TableName != nil {
+		return *x.TableName
+	}
+	return ""
+}
+
+func (x *AppDatabaseTableReadRequest) GetLockType() string {
+	if x != nil && x.LockType != nil {
+		return *x.LockType
+	}
+	return ""
+}
+
+func (x *AppDatabaseTableReadRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type AppDatabaseReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tables []*AppDatabaseTableReadResponse `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *AppDatabaseReadResponse) Reset() {
+	*x = AppDatabaseReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseReadResponse) ProtoMessage() {}
+
+func (x *AppDatabaseReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseReadResponse.ProtoReflect.Descriptor instead.
+func (*AppDatabaseReadResponse) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AppDatabaseReadResponse) GetTables() []*AppDatabaseTableReadResponse {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type AppDatabaseTableReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableName *string                       `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3,oneof" json:"table_name,omitempty"`
+	Rows      []*AppDatabaseRowReadResponse `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *AppDatabaseTableReadResponse) Reset() {
+	*x = AppDatabaseTableReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseTableReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseTableReadResponse) ProtoMessage() {}
+
+func (x *AppDatabaseTableReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseTableReadResponse.ProtoReflect.Descriptor instead.
+func (*AppDatabaseTableReadResponse) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AppDatabaseTableReadResponse) GetTableName() string {
+	if x != nil && x.TableName != nil {
+		return *x.TableName
+	}
+	return ""
+}
+
+func (x *AppDatabaseTableReadResponse) GetRows() []*AppDatabaseRowReadResponse {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type AppDatabaseRowReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Columns []*AppDatabaseColumnValue `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *AppDatabaseRowReadResponse) Reset() {
+	*x = AppDatabaseRowReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseRowReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseRowReadResponse) ProtoMessage() {}
+
+func (x *AppDatabaseRowReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseRowReadResponse.ProtoReflect.Descriptor instead.
+func (*AppDatabaseRowReadResponse) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AppDatabaseRowReadResponse) GetColumns() []*AppDatabaseColumnValue {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type AppDatabaseError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ErrorSubType
+	AppDbErrorType      string  `protobuf:"bytes,1,opt,name=app_db_error_type,json=appDBErrorType,proto3" json:"app_db_error_type,omitempty"`
+	AppDbErrorCode      string  `protobuf:"bytes,2,opt,name=app_db_error_code,json=appDBErrorCode,proto3" json:"app_db_error_code,omitempty"`
+	AppDbErrorMessage   *string `protobuf:"bytes,3,opt,name=app_db_error_message,json=appDBErrorMessage,proto3,oneof" json:"app_db_error_message,omitempty"`
+	AppDbErrorTableName *string `protobuf:"bytes,4,opt,name=app_db_error_table_name,json=appDBErrorTableName,proto3,oneof" json:"app_db_error_table_name,omitempty"`
+}
+
+func (x *AppDatabaseError) Reset() {
+	*x = AppDatabaseError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseError) ProtoMessage() {}
+
+func (x *AppDatabaseError) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseError.ProtoReflect.Descriptor instead.
+func (*AppDatabaseError) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AppDatabaseError) GetAppDbErrorType() string {
+	if x != nil {
+		return x.AppDbErrorType
+	}
+	return ""
+}
+
+func (x *AppDatabaseError) GetAppDbErrorCode() string {
+	if x != nil {
+		return x.AppDbErrorCode
+	}
+	return ""
+}
+
+func (x *AppDatabaseError) GetAppDbErrorMessage() string {
+	if x != nil && x.AppDbErrorMessage != nil {
+		return *x.AppDbErrorMessage
+	}
+	return ""
+}
+
+func (x *AppDatabaseError) GetAppDbErrorTableName() string {
+	if x != nil && x.AppDbErrorTableName != nil {
+		return *x.AppDbErrorTableName
+	}
+	return ""
+}
+
+type AppDatabaseWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tables []*AppDatabaseTableWrite `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *AppDatabaseWrite) Reset() {
+	*x = AppDatabaseWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseWrite) ProtoMessage() {}
+
+func (x *AppDatabaseWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseWrite.ProtoReflect.Descriptor instead.
+func (*AppDatabaseWrite) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AppDatabaseWrite) GetTables() []*AppDatabaseTableWrite {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type AppDatabaseTableWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableName string                 `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Rows      []*AppDatabaseRowWrite `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *AppDatabaseTableWrite) Reset() {
+	*x = AppDatabaseTableWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseTableWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseTableWrite) ProtoMessage() {}
+
+func (x *AppDatabaseTableWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseTableWrite.ProtoReflect.Descriptor instead.
+func (*AppDatabaseTableWrite) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AppDatabaseTableWrite) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *AppDatabaseTableWrite) GetRows() []*AppDatabaseRowWrite {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type AppDatabaseRowWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrimaryKey   []*AppDatabaseColumnValue `protobuf:"bytes,1,rep,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	WriteColumns []*AppDatabaseColumnValue `protobuf:"bytes,2,rep,name=write_columns,json=writeColumns,proto3" json:"write_columns,omitempty"`
+}
+
+func (x *AppDatabaseRowWrite) Reset() {
+	*x = AppDatabaseRowWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xcherry_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDatabaseRowWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDatabaseRowWrite) ProtoMessage() {}
+
+func (x *AppDatabaseRowWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_xcherry_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDatabaseRowWrite.ProtoReflect.Descriptor instead.
+func (*AppDatabaseRowWrite) Descriptor() ([]byte, []int) {
+	return file_xcherry_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AppDatabaseRowWrite) GetPrimaryKey() []*AppDatabaseColumnValue {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return nil
+}
+
+func (x *AppDatabaseRowWrite) GetWriteColumns() []*AppDatabaseColumnValue {
+	if x != nil {
+		return x.WriteColumns
+	}
+	return nil
+}
+
+var File_xcherry_proto protoreflect.FileDescriptor
+
+var file_xcherry_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x03, 0x0a, 0x1c, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x29, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x4a, 0x0a, 0x12, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x50, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x1d, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x8a,
+	0x01, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x73,
+	0x74, 0x6f, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5e, 0x0a, 0x1f, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0xc8, 0x02, 0x0a, 0x20,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x55, 0x72, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0e,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x04, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x17,
+	0x0a, 0x15, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x1a, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xc5, 0x01,
+	0x0a, 0x1a, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x61, 0x69, 0x74,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x1b, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x57, 0x61, 0x69, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x16, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x63, 0x68,
+	0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x13, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0x97,
+	0x04, 0x0a, 0x18, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x78,
+	0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x60, 0x0a, 0x1a, 0x61, 0x70, 0x70,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x17, 0x61, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x61,
+	0x70, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x10, 0x61, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5f, 0x0a, 0x17, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x15, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0xd3, 0x02, 0x0a, 0x19, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x16, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x13, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x4f, 0x0a, 0x15,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x63,
+	0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x12, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x54, 0x6f, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x4f, 0x0a,
+	0x19, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65,
+	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x3f,
+	0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x4d, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x78,
+	0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x97,
+	0x04, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x31, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x17, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x15, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x1f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x1b, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x61, 0x70, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0e, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x69, 0x88, 0x01, 0x01,
+	0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x42,
+	0x22, 0x0a, 0x20, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x22, 0x84, 0x06, 0x0a, 0x10, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a,
+	0x0f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x57, 0x61,
+	0x69, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1e, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x01, 0x52, 0x1a, 0x77, 0x61, 0x69, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x41,
+	0x70, 0x69, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x1b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x41, 0x70, 0x69, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x1b, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x78,
+	0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x17, 0x77, 0x61, 0x69, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x41, 0x70, 0x69, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x50,
+	0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x41, 0x70, 0x69, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x6c, 0x0a, 0x1e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x1b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5d,
+	0x0a, 0x19, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x16, 0x61, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x69, 0x0a,
+	0x1d, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x1a, 0x6c, 0x6f,
+	0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x6b, 0x69,
+	0x70, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x21, 0x0a, 0x1f,
+	0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x61, 0x70, 0x69, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42,
+	0x1e, 0x0a, 0x1c, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0xce, 0x03, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x3d, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x34,
+	0x0a, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69,
+	0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x12, 0x62,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x21, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04,
+	0x52, 0x1e, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x6f, 0x65,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x42, 0x24, 0x0a, 0x22, 0x5f, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x8b, 0x02, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x47, 0x0a, 0x1e, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x1a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x68, 0x0a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x1e, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x21, 0x0a, 0x1f, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xbe,
+	0x02, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x75, 0x73, 0x65, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d,
+	0x69, 0x64, 0x52, 0x65, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x4d, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x61, 0x70,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x56, 0x0a, 0x16, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x69, 0x64, 0x5f, 0x72, 0x65, 0x75, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x51, 0x0a, 0x14, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x1a, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x14, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x6e, 0x6f, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x6b, 0x65, 0x79, 0x73, 0x54, 0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x4e, 0x6f, 0x4c, 0x6f, 0x63,
+	0x6b, 0x12, 0x32, 0x0a, 0x16, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x12, 0x6b, 0x65, 0x79, 0x73, 0x54, 0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x74,
+	0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x53, 0x0a, 0x1b, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x08, 0x64, 0x65, 0x64, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x64, 0x75, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x65, 0x64, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x22, 0xc5, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x54, 0x69, 0x6d,
+	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x57, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9c, 0x01, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x3c, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4c, 0x0a,
+	0x13, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x63, 0x68,
+	0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x25, 0x0a, 0x0b, 0x54,
+	0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f,
+	0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
+	0x69, 0x0a, 0x17, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65,
+	0x64, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65,
+	0x64, 0x75, 0x70, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x0d, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x15, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x01,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78,
+	0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x70, 0x0a, 0x13, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a,
+	0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4f, 0x0a, 0x11, 0x41, 0x70, 0x70,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a,
+	0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x16, 0x41, 0x70,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x6f, 0x77, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x22, 0xe7, 0x01, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x43, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x47, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78,
+	0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x28,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x51, 0x0a, 0x16, 0x41, 0x70,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x59, 0x0a,
+	0x16, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x1b, 0x41, 0x70, 0x70,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5b, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x40, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x6f,
+	0x77, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x8e,
+	0x02, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x62, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x70, 0x70, 0x44, 0x42, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29,
+	0x0a, 0x11, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x62, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x44, 0x42,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x14, 0x61, 0x70, 0x70,
+	0x5f, 0x64, 0x62, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x61, 0x70, 0x70, 0x44, 0x42,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x17, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x62, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x13, 0x61, 0x70, 0x70, 0x44, 0x42, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x61,
+	0x70, 0x70, 0x5f, 0x64, 0x62, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x62, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x4d, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x6b,
+	0x0a, 0x15, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x6f, 0x77,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x13,
+	0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x6f, 0x77, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x47, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x32, 0xf5, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x28, 0x2e,
+	0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x27, 0x2e, 0x78, 0x63, 0x68,
+	0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x65, 0x0a, 0x08, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2b, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x26, 0x2e, 0x78, 0x63, 0x68, 0x65,
+	0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xd9, 0x01, 0x0a, 0x0d, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x61, 0x69, 0x74, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x26, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x61, 0x69, 0x74, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x78, 0x63, 0x68,
+	0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x57, 0x61, 0x69, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x78, 0x63, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x63, 0x68, 0x65, 0x72, 0x72, 0x79, 0x69, 0x6f, 0x2f, 0x73, 0x64,
+	0x6b, 0x2d, 0x67, 0x6f, 0x2f, 0x78, 0x63, 0x2f, 0x78, 0x63, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x78,
+	0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xcherry_proto_rawDescOnce sync.Once
+	file_xcherry_proto_rawDescData = file_xcherry_proto_rawDesc
+)
+
+func file_xcherry_proto_rawDescGZIP() []byte {
+	file_xcherry_proto_rawDescOnce.Do(func() {
+		file_xcherry_proto_rawDescData = protoimpl.X.CompressGZIP(file_xcherry_proto_rawDescData)
+	})
+	return file_xcherry_proto_rawDescData
+}
+
+var file_xcherry_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_xcherry_proto_goTypes = []interface{}{
+	(*ProcessExecutionStartRequest)(nil),     // 0: xcherry.v1.ProcessExecutionStartRequest
+	(*ProcessExecutionStartResponse)(nil),    // 1: xcherry.v1.ProcessExecutionStartResponse
+	(*ProcessExecutionStopRequest)(nil),      // 2: xcherry.v1.ProcessExecutionStopRequest
+	(*ProcessExecutionDescribeRequest)(nil),  // 3: xcherry.v1.ProcessExecutionDescribeRequest
+	(*ProcessExecutionDescribeResponse)(nil), // 4: xcherry.v1.ProcessExecutionDescribeResponse
+	(*PublishToLocalQueueRequest)(nil),       // 5: xcherry.v1.PublishToLocalQueueRequest
+	(*AsyncStateWaitUntilRequest)(nil),       // 6: xcherry.v1.AsyncStateWaitUntilRequest
+	(*AsyncStateWaitUntilResponse)(nil),      // 7: xcherry.v1.AsyncStateWaitUntilResponse
+	(*AsyncStateExecuteRequest)(nil),         // 8: xcherry.v1.AsyncStateExecuteRequest
+	(*AsyncStateExecuteResponse)(nil),        // 9: xcherry.v1.AsyncStateExecuteResponse
+	(*EncodedObject)(nil),                    // 10: xcherry.v1.EncodedObject
+	(*KeyValue)(nil),                         // 11: xcherry.v1.KeyValue
+	(*Context)(nil),                          // 12: xcherry.v1.Context
+	(*AsyncStateConfig)(nil),                 // 13: xcherry.v1.AsyncStateConfig
+	(*RetryPolicy)(nil),                      // 14: xcherry.v1.RetryPolicy
+	(*StateFailureRecoveryOptions)(nil),      // 15: xcherry.v1.StateFailureRecoveryOptions
+	(*ProcessStartConfig)(nil),               // 16: xcherry.v1.ProcessStartConfig
+	(*LocalAttributeConfig)(nil),             // 17: xcherry.v1.LocalAttributeConfig
+	(*LoadLocalAttributesRequest)(nil),       // 18: xcherry.v1.LoadLocalAttributesRequest
+	(*LoadLocalAttributesResponse)(nil),      // 19: xcherry.v1.LoadLocalAttributesResponse
+	(*LocalQueueMessage)(nil),                // 20: xcherry.v1.LocalQueueMessage
+	(*CommandRequest)(nil),                   // 21: xcherry.v1.CommandRequest
+	(*TimerCommand)(nil),                     // 22: xcherry.v1.TimerCommand
+	(*LocalQueueCommand)(nil),                // 23: xcherry.v1.LocalQueueCommand
+	(*CommandResults)(nil),                   // 24: xcherry.v1.CommandResults
+	(*TimerResult)(nil),                      // 25: xcherry.v1.TimerResult
+	(*LocalQueueResult)(nil),                 // 26: xcherry.v1.LocalQueueResult
+	(*LocalQueueMessageResult)(nil),          // 27: xcherry.v1.LocalQueueMessageResult
+	(*StateDecision)(nil),                    // 28: xcherry.v1.StateDecision
+	(*StateMovement)(nil),                    // 29: xcherry.v1.StateMovement
+	(*ThreadCloseDecision)(nil),              // 30: xcherry.v1.ThreadCloseDecision
+	(*AppDatabaseConfig)(nil),                // 31: xcherry.v1.AppDatabaseConfig
+	(*AppDatabaseTableConfig)(nil),           // 32: xcherry.v1.AppDatabaseTableConfig
+	(*AppDatabaseTableRowSelector)(nil),      // 33: xcherry.v1.AppDatabaseTableRowSelector
+	(*AppDatabaseColumnValue)(nil),           // 34: xcherry.v1.AppDatabaseColumnValue
+	(*AppDatabaseReadRequest)(nil),           // 35: xcherry.v1.AppDatabaseReadRequest
+	(*AppDatabaseTableReadRequest)(nil),      // 36: xcherry.v1.AppDatabaseTableReadRequest
+	(*AppDatabaseReadResponse)(nil),          // 37: xcherry.v1.AppDatabaseReadResponse
+	(*AppDatabaseTableReadResponse)(nil),     // 38: xcherry.v1.AppDatabaseTableReadResponse
+	(*AppDatabaseRowReadResponse)(nil),       // 39: xcherry.v1.AppDatabaseRowReadResponse
+	(*AppDatabaseError)(nil),                 // 40: xcherry.v1.AppDatabaseError
+	(*AppDatabaseWrite)(nil),                 // 41: xcherry.v1.AppDatabaseWrite
+	(*AppDatabaseTableWrite)(nil),            // 42: xcherry.v1.AppDatabaseTableWrite
+	(*AppDatabaseRowWrite)(nil),              // 43: xcherry.v1.AppDatabaseRowWrite
+	(*emptypb.Empty)(nil),                    // 44: google.protobuf.Empty
+}
+var file_xcherry_proto_depIdxs = []int32{
+	10, // 0: xcherry.v1.ProcessExecutionStartRequest.start_state_input:type_name -> xcherry.v1.EncodedObject
+	13, // 1: xcherry.v1.ProcessExecutionStartRequest.start_state_config:type_name -> xcherry.v1.AsyncStateConfig
+	16, // 2: xcherry.v1.ProcessExecutionStartRequest.process_start_config:type_name -> xcherry.v1.ProcessStartConfig
+	20, // 3: xcherry.v1.PublishToLocalQueueRequest.messages:type_name -> xcherry.v1.LocalQueueMessage
+	12, // 4: xcherry.v1.AsyncStateWaitUntilRequest.context:type_name -> xcherry.v1.Context
+	10, // 5: xcherry.v1.AsyncStateWaitUntilRequest.state_input:type_name -> xcherry.v1.EncodedObject
+	21, // 6: xcherry.v1.AsyncStateWaitUntilResponse.command_request:type_name -> xcherry.v1.CommandRequest
+	20, // 7: xcherry.v1.AsyncStateWaitUntilResponse.publish_to_local_queue:type_name -> xcherry.v1.LocalQueueMessage
+	12, // 8: xcherry.v1.AsyncStateExecuteRequest.context:type_name -> xcherry.v1.Context
+	10, // 9: xcherry.v1.AsyncStateExecuteRequest.state_input:type_name -> xcherry.v1.EncodedObject
+	24, // 10: xcherry.v1.AsyncStateExecuteRequest.command_results:type_name -> xcherry.v1.CommandResults
+	37, // 11: xcherry.v1.AsyncStateExecuteRequest.app_database_read_response:type_name -> xcherry.v1.AppDatabaseReadResponse
+	40, // 12: xcherry.v1.AsyncStateExecuteRequest.app_database_error:type_name -> xcherry.v1.AppDatabaseError
+	19, // 13: xcherry.v1.AsyncStateExecuteRequest.loaded_local_attributes:type_name -> xcherry.v1.LoadLocalAttributesResponse
+	28, // 14: xcherry.v1.AsyncStateExecuteResponse.state_decision:type_name -> xcherry.v1.StateDecision
+	20, // 15: xcherry.v1.AsyncStateExecuteResponse.publish_to_local_queue:type_name -> xcherry.v1.LocalQueueMessage
+	41, // 16: xcherry.v1.AsyncStateExecuteResponse.write_to_app_database:type_name -> xcherry.v1.AppDatabaseWrite
+	11, // 17: xcherry.v1.AsyncStateExecuteResponse.write_to_local_attributes:type_name -> xcherry.v1.KeyValue
+	10, // 18: xcherry.v1.KeyValue.value:type_name -> xcherry.v1.EncodedObject
+	14, // 19: xcherry.v1.AsyncStateConfig.wait_until_api_retry_policy:type_name -> xcherry.v1.RetryPolicy
+	14, // 20: xcherry.v1.AsyncStateConfig.execute_api_retry_policy:type_name -> xcherry.v1.RetryPolicy
+	15, // 21: xcherry.v1.AsyncStateConfig.state_failure_recovery_options:type_name -> xcherry.v1.StateFailureRecoveryOptions
+	35, // 22: xcherry.v1.AsyncStateConfig.app_database_read_request:type_name -> xcherry.v1.AppDatabaseReadRequest
+	18, // 23: xcherry.v1.AsyncStateConfig.load_local_attributes_request:type_name -> xcherry.v1.LoadLocalAttributesRequest
+	13, // 24: xcherry.v1.StateFailureRecoveryOptions.state_failure_proceed_state_config:type_name -> xcherry.v1.AsyncStateConfig
+	31, // 25: xcherry.v1.ProcessStartConfig.app_database_config:type_name -> xcherry.v1.AppDatabaseConfig
+	17, // 26: xcherry.v1.ProcessStartConfig.local_attribute_config:type_name -> xcherry.v1.LocalAttributeConfig
+	11, // 27: xcherry.v1.LocalAttributeConfig.initial_write:type_name -> xcherry.v1.KeyValue
+	11, // 28: xcherry.v1.LoadLocalAttributesResponse.attributes:type_name -> xcherry.v1.KeyValue
+	10, // 29: xcherry.v1.LocalQueueMessage.payload:type_name -> xcherry.v1.EncodedObject
+	22, // 30: xcherry.v1.CommandRequest.timer_commands:type_name -> xcherry.v1.TimerCommand
+	23, // 31: xcherry.v1.CommandRequest.local_queue_commands:type_name -> xcherry.v1.LocalQueueCommand
+	25, // 32: xcherry.v1.CommandResults.timer_results:type_name -> xcherry.v1.TimerResult
+	26, // 33: xcherry.v1.CommandResults.local_queue_results:type_name -> xcherry.v1.LocalQueueResult
+	27, // 34: xcherry.v1.LocalQueueResult.messages:type_name -> xcherry.v1.LocalQueueMessageResult
+	10, // 35: xcherry.v1.LocalQueueMessageResult.payload:type_name -> xcherry.v1.EncodedObject
+	29, // 36: xcherry.v1.StateDecision.next_states:type_name -> xcherry.v1.StateMovement
+	30, // 37: xcherry.v1.StateDecision.thread_close_decision:type_name -> xcherry.v1.ThreadCloseDecision
+	10, // 38: xcherry.v1.StateMovement.state_input:type_name -> xcherry.v1.EncodedObject
+	13, // 39: xcherry.v1.StateMovement.state_config:type_name -> xcherry.v1.AsyncStateConfig
+	10, // 40: xcherry.v1.ThreadCloseDecision.close_input:type_name -> xcherry.v1.EncodedObject
+	32, // 41: xcherry.v1.AppDatabaseConfig.tables:type_name -> xcherry.v1.AppDatabaseTableConfig
+	33, // 42: xcherry.v1.AppDatabaseTableConfig.rows:type_name -> xcherry.v1.AppDatabaseTableRowSelector
+	34, // 43: xcherry.v1.AppDatabaseTableRowSelector.primary_key:type_name -> xcherry.v1.AppDatabaseColumnValue
+	34, // 44: xcherry.v1.AppDatabaseTableRowSelector.initial_write:type_name -> xcherry.v1.AppDatabaseColumnValue
+	36, // 45: xcherry.v1.AppDatabaseReadRequest.tables:type_name -> xcherry.v1.AppDatabaseTableReadRequest
+	38, // 46: xcherry.v1.AppDatabaseReadResponse.tables:type_name -> xcherry.v1.AppDatabaseTableReadResponse
+	39, // 47: xcherry.v1.AppDatabaseTableReadResponse.rows:type_name -> xcherry.v1.AppDatabaseRowReadResponse
+	34, // 48: xcherry.v1.AppDatabaseRowReadResponse.columns:type_name -> xcherry.v1.AppDatabaseColumnValue
+	42, // 49: xcherry.v1.AppDatabaseWrite.tables:type_name -> xcherry.v1.AppDatabaseTableWrite
+	43, // 50: xcherry.v1.AppDatabaseTableWrite.rows:type_name -> xcherry.v1.AppDatabaseRowWrite
+	34, // 51: xcherry.v1.AppDatabaseRowWrite.primary_key:type_name -> xcherry.v1.AppDatabaseColumnValue
+	34, // 52: xcherry.v1.AppDatabaseRowWrite.write_columns:type_name -> xcherry.v1.AppDatabaseColumnValue
+	0,  // 53: xcherry.v1.ProcessService.Start:input_type -> xcherry.v1.ProcessExecutionStartRequest
+	2,  // 54: xcherry.v1.ProcessService.Stop:input_type -> xcherry.v1.ProcessExecutionStopRequest
+	3,  // 55: xcherry.v1.ProcessService.Describe:input_type -> xcherry.v1.ProcessExecutionDescribeRequest
+	5,  // 56: xcherry.v1.ProcessService.PublishToLocalQueue:input_type -> xcherry.v1.PublishToLocalQueueRequest
+	6,  // 57: xcherry.v1.WorkerService.AsyncStateWaitUntil:input_type -> xcherry.v1.AsyncStateWaitUntilRequest
+	8,  // 58: xcherry.v1.WorkerService.AsyncStateExecute:input_type -> xcherry.v1.AsyncStateExecuteRequest
+	1,  // 59: xcherry.v1.ProcessService.Start:output_type -> xcherry.v1.ProcessExecutionStartResponse
+	44, // 60: xcherry.v1.ProcessService.Stop:output_type -> google.protobuf.Empty
+	4,  // 61: xcherry.v1.ProcessService.Describe:output_type -> xcherry.v1.ProcessExecutionDescribeResponse
+	44, // 62: xcherry.v1.ProcessService.PublishToLocalQueue:output_type -> google.protobuf.Empty
+	7,  // 63: xcherry.v1.WorkerService.AsyncStateWaitUntil:output_type -> xcherry.v1.AsyncStateWaitUntilResponse
+	9,  // 64: xcherry.v1.WorkerService.AsyncStateExecute:output_type -> xcherry.v1.AsyncStateExecuteResponse
+	59, // [59:65] is the sub-list for method output_type
+	53, // [53:59] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
+}
+
+func init() { file_xcherry_proto_init() }
+func file_xcherry_proto_init() {
+	if File_xcherry_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_xcherry_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessExecutionStartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessExecutionStartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessExecutionStopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessExecutionDescribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessExecutionDescribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishToLocalQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncStateWaitUntilRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncStateWaitUntilResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncStateExecuteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncStateExecuteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodedObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Context); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncStateConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateFailureRecoveryOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessStartConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalAttributeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadLocalAttributesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadLocalAttributesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalQueueMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalQueueCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalQueueResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalQueueMessageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateMovement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadCloseDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseTableConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseTableRowSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseColumnValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseTableReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseTableReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseRowReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseWrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseTableWrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xcherry_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDatabaseRowWrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_xcherry_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_xcherry_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xcherry_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   44,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_xcherry_proto_goTypes,
+		DependencyIndexes: file_xcherry_proto_depIdxs,
+		MessageInfos:      file_xcherry_proto_msgTypes,
+	}.Build()
+	File_xcherry_proto = out.File
+	file_xcherry_proto_rawDesc = nil
+	file_xcherry_proto_goTypes = nil
+	file_xcherry_proto_depIdxs = nil
+}
@@ -0,0 +1,353 @@
+// The gRPC services of xCherry, mirroring the REST APIs of the server and the worker.
+// Each message mirrors the xCherry API model of the same name, see https://github.com/xcherryio/apis.
+// The fields of the enums of the models(e.g. ProcessStatus) are the strings of the enum values.
+// See package xcgrpc for the Go implementation and the mapping to and from the xcapi models.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.23.4
+// source: xcherry.proto
+
+package xcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ProcessService_Start_FullMethodName               = "/xcherry.v1.ProcessService/Start"
+	ProcessService_Stop_FullMethodName                = "/xcherry.v1.ProcessService/Stop"
+	ProcessService_Describe_FullMethodName            = "/xcherry.v1.ProcessService/Describe"
+	ProcessService_PublishToLocalQueue_FullMethodName = "/xcherry.v1.ProcessService/PublishToLocalQueue"
+)
+
+// ProcessServiceClient is the client API for ProcessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProcessServiceClient interface {
+	Start(ctx context.Context, in *ProcessExecutionStartRequest, opts ...grpc.CallOption) (*ProcessExecutionStartResponse, error)
+	Stop(ctx context.Context, in *ProcessExecutionStopRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Describe(ctx context.Context, in *ProcessExecutionDescribeRequest, opts ...grpc.CallOption) (*ProcessExecutionDescribeResponse, error)
+	PublishToLocalQueue(ctx context.Context, in *PublishToLocalQueueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type processServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProcessServiceClient(cc grpc.ClientConnInterface) ProcessServiceClient {
+	return &processServiceClient{cc}
+}
+
+func (c *processServiceClient) Start(ctx context.Context, in *ProcessExecutionStartRequest, opts ...grpc.CallOption) (*ProcessExecutionStartResponse, error) {
+	out := new(ProcessExecutionStartResponse)
+	err := c.cc.Invoke(ctx, ProcessService_Start_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processServiceClient) Stop(ctx context.Context, in *ProcessExecutionStopRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProcessService_Stop_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processServiceClient) Describe(ctx context.Context, in *ProcessExecutionDescribeRequest, opts ...grpc.CallOption) (*ProcessExecutionDescribeResponse, error) {
+	out := new(ProcessExecutionDescribeResponse)
+	err := c.cc.Invoke(ctx, ProcessService_Describe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processServiceClient) PublishToLocalQueue(ctx context.Context, in *PublishToLocalQueueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProcessService_PublishToLocalQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProcessServiceServer is the server API for ProcessService service.
+// All implementations must embed UnimplementedProcessServiceServer
+// for forward compatibility
+type ProcessServiceServer interface {
+	Start(context.Context, *ProcessExecutionStartRequest) (*ProcessExecutionStartResponse, error)
+	Stop(context.Context, *ProcessExecutionStopRequest) (*emptypb.Empty, error)
+	Describe(context.Context, *ProcessExecutionDescribeRequest) (*ProcessExecutionDescribeResponse, error)
+	PublishToLocalQueue(context.Context, *PublishToLocalQueueRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedProcessServiceServer()
+}
+
+// UnimplementedProcessServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProcessServiceServer struct {
+}
+
+func (UnimplementedProcessServiceServer) Start(context.Context, *ProcessExecutionStartRequest) (*ProcessExecutionStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (UnimplementedProcessServiceServer) Stop(context.Context, *ProcessExecutionStopRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedProcessServiceServer) Describe(context.Context, *ProcessExecutionDescribeRequest) (*ProcessExecutionDescribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedProcessServiceServer) PublishToLocalQueue(context.Context, *PublishToLocalQueueRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishToLocalQueue not implemented")
+}
+func (UnimplementedProcessServiceServer) mustEmbedUnimplementedProcessServiceServer() {}
+
+// UnsafeProcessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProcessServiceServer will
+// result in compilation errors.
+type UnsafeProcessServiceServer interface {
+	mustEmbedUnimplementedProcessServiceServer()
+}
+
+func RegisterProcessServiceServer(s grpc.ServiceRegistrar, srv ProcessServiceServer) {
+	s.RegisterService(&ProcessService_ServiceDesc, srv)
+}
+
+func _ProcessService_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessExecutionStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessServiceServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessService_Start_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessServiceServer).Start(ctx, req.(*ProcessExecutionStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessService_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessExecutionStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessServiceServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessService_Stop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessServiceServer).Stop(ctx, req.(*ProcessExecutionStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessService_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessExecutionDescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessServiceServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessService_Describe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessServiceServer).Describe(ctx, req.(*ProcessExecutionDescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessService_PublishToLocalQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishToLocalQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessServiceServer).PublishToLocalQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessService_PublishToLocalQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessServiceServer).PublishToLocalQueue(ctx, req.(*PublishToLocalQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProcessService_ServiceDesc is the grpc.ServiceDesc for ProcessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProcessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xcherry.v1.ProcessService",
+	HandlerType: (*ProcessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Start",
+			Handler:    _ProcessService_Start_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _ProcessService_Stop_Handler,
+		},
+		{
+			MethodName: "Describe",
+			Handler:    _ProcessService_Describe_Handler,
+		},
+		{
+			MethodName: "PublishToLocalQueue",
+			Handler:    _ProcessService_PublishToLocalQueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xcherry.proto",
+}
+
+const (
+	WorkerService_AsyncStateWaitUntil_FullMethodName = "/xcherry.v1.WorkerService/AsyncStateWaitUntil"
+	WorkerService_AsyncStateExecute_FullMethodName   = "/xcherry.v1.WorkerService/AsyncStateExecute"
+)
+
+// WorkerServiceClient is the client API for WorkerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkerServiceClient interface {
+	AsyncStateWaitUntil(ctx context.Context, in *AsyncStateWaitUntilRequest, opts ...grpc.CallOption) (*AsyncStateWaitUntilResponse, error)
+	AsyncStateExecute(ctx context.Context, in *AsyncStateExecuteRequest, opts ...grpc.CallOption) (*AsyncStateExecuteResponse, error)
+}
+
+type workerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkerServiceClient(cc grpc.ClientConnInterface) WorkerServiceClient {
+	return &workerServiceClient{cc}
+}
+
+func (c *workerServiceClient) AsyncStateWaitUntil(ctx context.Context, in *AsyncStateWaitUntilRequest, opts ...grpc.CallOption) (*AsyncStateWaitUntilResponse, error) {
+	out := new(AsyncStateWaitUntilResponse)
+	err := c.cc.Invoke(ctx, WorkerService_AsyncStateWaitUntil_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) AsyncStateExecute(ctx context.Context, in *AsyncStateExecuteRequest, opts ...grpc.CallOption) (*AsyncStateExecuteResponse, error) {
+	out := new(AsyncStateExecuteResponse)
+	err := c.cc.Invoke(ctx, WorkerService_AsyncStateExecute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkerServiceServer is the server API for WorkerService service.
+// All implementations must embed UnimplementedWorkerServiceServer
+// for forward compatibility
+type WorkerServiceServer interface {
+	AsyncStateWaitUntil(context.Context, *AsyncStateWaitUntilRequest) (*AsyncStateWaitUntilResponse, error)
+	AsyncStateExecute(context.Context, *AsyncStateExecuteRequest) (*AsyncStateExecuteResponse, error)
+	mustEmbedUnimplementedWorkerServiceServer()
+}
+
+// UnimplementedWorkerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWorkerServiceServer struct {
+}
+
+func (UnimplementedWorkerServiceServer) AsyncStateWaitUntil(context.Context, *AsyncStateWaitUntilRequest) (*AsyncStateWaitUntilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AsyncStateWaitUntil not implemented")
+}
+func (UnimplementedWorkerServiceServer) AsyncStateExecute(context.Context, *AsyncStateExecuteRequest) (*AsyncStateExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AsyncStateExecute not implemented")
+}
+func (UnimplementedWorkerServiceServer) mustEmbedUnimplementedWorkerServiceServer() {}
+
+// UnsafeWorkerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkerServiceServer will
+// result in compilation errors.
+type UnsafeWorkerServiceServer interface {
+	mustEmbedUnimplementedWorkerServiceServer()
+}
+
+func RegisterWorkerServiceServer(s grpc.ServiceRegistrar, srv WorkerServiceServer) {
+	s.RegisterService(&WorkerService_ServiceDesc, srv)
+}
+
+func _WorkerService_AsyncStateWaitUntil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AsyncStateWaitUntilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).AsyncStateWaitUntil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_AsyncStateWaitUntil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).AsyncStateWaitUntil(ctx, req.(*AsyncStateWaitUntilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_AsyncStateExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AsyncStateExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).AsyncStateExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_AsyncStateExecute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).AsyncStateExecute(ctx, req.(*AsyncStateExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkerService_ServiceDesc is the grpc.ServiceDesc for WorkerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xcherry.v1.WorkerService",
+	HandlerType: (*WorkerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AsyncStateWaitUntil",
+			Handler:    _WorkerService_AsyncStateWaitUntil_Handler,
+		},
+		{
+			MethodName: "AsyncStateExecute",
+			Handler:    _WorkerService_AsyncStateExecute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xcherry.proto",
+}